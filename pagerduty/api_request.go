@@ -0,0 +1,106 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// doAPIRequest sends a GET request for an endpoint the client has no support
+// for yet. The request is built from the client configuration the same way
+// the client builds its own, and failures are returned as client errors.
+func doAPIRequest(ctx context.Context, client *pagerduty.Client, path string, v interface{}) error {
+	return doAPIRequestWithBody(ctx, client, http.MethodGet, path, nil, v)
+}
+
+// doAPIRequestWithBody is doAPIRequest for requests sending body as JSON, for
+// payloads the client can't encode. Like the client, it gets a new App Oauth
+// scoped token and retries once when the API rejects the current one.
+func doAPIRequestWithBody(ctx context.Context, client *pagerduty.Client, method, path string, body, v interface{}) error {
+	status, err := sendAPIRequest(ctx, client, method, path, body, v)
+	if status != http.StatusUnauthorized || !usesAppCredentials(client) {
+		return err
+	}
+
+	// The client gets a new token when a request of its own is rejected, so
+	// any request going through it is enough to refresh the token.
+	log.Printf("[INFO] %s API call to %s was not authorized, getting a new App Oauth scoped token", method, path)
+	if authErr := client.ValidateAuth(); authErr != nil {
+		return fmt.Errorf("failed to get a new App Oauth scoped token: %w", authErr)
+	}
+
+	_, err = sendAPIRequest(ctx, client, method, path, body, v)
+	return err
+}
+
+// sendAPIRequest sends a single request and also returns the status code of
+// the response, or 0 when none was received.
+func sendAPIRequest(ctx context.Context, client *pagerduty.Client, method, path string, body, v interface{}) (int, error) {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, client.Config.BaseURL+path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+
+	authHeader := fmt.Sprintf("Token token=%s", client.Config.Token)
+	if t := client.Config.APIAuthTokenType; t != nil && (*t == pagerduty.AuthTokenTypeUseAppCredentials || *t == pagerduty.AuthTokenTypeScopedOauthToken) {
+		authHeader = fmt.Sprintf("Bearer %s", client.Config.AppOauthScopedTokenParams.Token)
+	}
+	req.Header.Add("Accept", "application/vnd.pagerduty+json;version=2")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", client.Config.UserAgent)
+	req.Header.Add("Authorization", authHeader)
+
+	// Only the method and URL are logged, so the token never ends up in
+	// the logs.
+	log.Printf("[DEBUG] Sending %s API call to %s", req.Method, req.URL.String())
+	res, err := client.Config.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	log.Printf("[DEBUG] %s API call to %s returned %s", req.Method, req.URL.String(), res.Status)
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res.StatusCode, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		// Report the failure as a client error, so it's handled like the
+		// errors of any other request.
+		errResp := struct {
+			Error *pagerduty.Error `json:"error"`
+		}{
+			Error: &pagerduty.Error{ErrorResponse: &pagerduty.Response{Response: res, BodyBytes: resBody}},
+		}
+		if err := json.Unmarshal(resBody, &errResp); err != nil || errResp.Error == nil {
+			return res.StatusCode, fmt.Errorf("%s API call to %s failed: %v", req.Method, req.URL.String(), res.Status)
+		}
+		return res.StatusCode, errResp.Error
+	}
+
+	if v == nil {
+		return res.StatusCode, nil
+	}
+	return res.StatusCode, json.Unmarshal(resBody, v)
+}
+
+func usesAppCredentials(client *pagerduty.Client) bool {
+	t := client.Config.APIAuthTokenType
+	return t != nil && *t == pagerduty.AuthTokenTypeUseAppCredentials
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"log"
@@ -43,6 +44,14 @@ type Config struct {
 	// Do not verify TLS certs for HTTPS requests - useful if you're behind a corporate proxy
 	InsecureTls bool
 
+	// Keep the last known state of resources whose feature isn't included in
+	// the account plan instead of failing refresh
+	SkipUnavailableFeatures bool
+
+	// Check the token can manage the resource types in the plan before
+	// changing anything
+	PreflightPermissions bool
+
 	APITokenType *pagerduty.AuthTokenType
 
 	AppOauthScopedTokenParams *persistentconfig.AppOauthScopedTokenParams
@@ -51,6 +60,9 @@ type Config struct {
 
 	client      *pagerduty.Client
 	slackClient *pagerduty.Client
+
+	permissionsMu    sync.Mutex
+	tokenPermissions *util.TokenPermissions
 }
 
 const invalidCreds = `
@@ -60,6 +72,36 @@ Please see https://www.terraform.io/docs/providers/pagerduty/index.html
 for more information on providing credentials for this provider.
 `
 
+// TokenPermissions returns what the configured token can manage, looking it up
+// once. Tokens that don't belong to a user, like account level API tokens,
+// are reported without a role.
+func (c *Config) TokenPermissions(ctx context.Context) (util.TokenPermissions, error) {
+	client, err := c.Client()
+	if err != nil {
+		return util.TokenPermissions{}, err
+	}
+
+	c.permissionsMu.Lock()
+	defer c.permissionsMu.Unlock()
+
+	if c.tokenPermissions != nil {
+		return *c.tokenPermissions, nil
+	}
+
+	var resp struct {
+		User struct {
+			Role string `json:"role"`
+		} `json:"user"`
+	}
+	err = doAPIRequest(ctx, client, "/users/me", &resp)
+	if err != nil && !isErrCode(err, http.StatusBadRequest) {
+		return util.TokenPermissions{}, fmt.Errorf("failed to look up the permissions of the API token: %w", err)
+	}
+
+	c.tokenPermissions = &util.TokenPermissions{Role: resp.User.Role}
+	return *c.tokenPermissions, nil
+}
+
 // Client returns a PagerDuty client, initializing when necessary.
 func (c *Config) Client() (*pagerduty.Client, error) {
 	c.mu.Lock()
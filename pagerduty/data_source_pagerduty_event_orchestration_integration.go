@@ -29,6 +29,10 @@ func dataSourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"routing_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"parameters": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -94,7 +98,7 @@ func getEventOrchestrationIntegrationById(ctx context.Context, d *schema.Resourc
 			return retry.RetryableError(err)
 		} else if integration != nil {
 			d.SetId(integration.ID)
-			setEventOrchestrationIntegrationProps(d, integration)
+			setEventOrchestrationIntegrationDataSourceProps(d, integration)
 		}
 		return nil
 	})
@@ -153,7 +157,7 @@ func getEventOrchestrationIntegrationByLabel(ctx context.Context, d *schema.Reso
 
 		found := matches[0]
 		d.SetId(found.ID)
-		setEventOrchestrationIntegrationProps(d, found)
+		setEventOrchestrationIntegrationDataSourceProps(d, found)
 
 		return nil
 	})
@@ -164,3 +168,13 @@ func getEventOrchestrationIntegrationByLabel(ctx context.Context, d *schema.Reso
 
 	return diags
 }
+
+func setEventOrchestrationIntegrationDataSourceProps(d *schema.ResourceData, i *pagerduty.EventOrchestrationIntegration) {
+	setEventOrchestrationIntegrationProps(d, i)
+
+	routingKey := ""
+	if i.Parameters != nil {
+		routingKey = i.Parameters.RoutingKey
+	}
+	d.Set("routing_key", routingKey)
+}
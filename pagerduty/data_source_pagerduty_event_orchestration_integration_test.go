@@ -91,6 +91,10 @@ func testAccDataSourcePagerDutyEventOrchestrationIntegration(src, n string) reso
 			}
 		}
 
+		if a["routing_key"] != srcA["parameters.0.routing_key"] {
+			return fmt.Errorf("Expected the Event Orchestration Integration routing_key to be: %s, but got: %s", srcA["parameters.0.routing_key"], a["routing_key"])
+		}
+
 		return nil
 	}
 }
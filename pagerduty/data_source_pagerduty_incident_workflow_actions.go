@@ -0,0 +1,239 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyIncidentWorkflowActions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyIncidentWorkflowActionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"keyword": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the actions matching this keyword",
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inputs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"parameter_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"required": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"default_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"outputs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"parameter_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyIncidentWorkflowActionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty incident workflow actions")
+
+	keyword := d.Get("keyword").(string)
+
+	var actions []*incidentWorkflowAction
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var err error
+		actions, err = listIncidentWorkflowActions(ctx, client, keyword)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusUnauthorized) ||
+				isErrCode(err, http.StatusForbidden) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+	if err := d.Set("actions", flattenIncidentWorkflowActions(actions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+type incidentWorkflowAction struct {
+	ID          string                             `json:"id"`
+	Name        string                             `json:"name"`
+	Description string                             `json:"description"`
+	Domain      string                             `json:"domain"`
+	Inputs      []*incidentWorkflowActionParameter `json:"inputs"`
+	Outputs     []*incidentWorkflowActionParameter `json:"outputs"`
+}
+
+type incidentWorkflowActionParameter struct {
+	Name          string      `json:"name"`
+	ParameterType string      `json:"parameter_type"`
+	Description   string      `json:"description"`
+	IsRequired    bool        `json:"is_required"`
+	DefaultValue  interface{} `json:"default_value"`
+}
+
+type listIncidentWorkflowActionsResponse struct {
+	Actions    []*incidentWorkflowAction `json:"actions"`
+	NextCursor string                    `json:"next_cursor"`
+}
+
+// listIncidentWorkflowActions lists the action catalog of Incident Workflows,
+// which the client has no support for yet.
+func listIncidentWorkflowActions(ctx context.Context, client *pagerduty.Client, keyword string) ([]*incidentWorkflowAction, error) {
+	var actions []*incidentWorkflowAction
+
+	cursor := ""
+	for {
+		q := url.Values{}
+		q.Set("limit", "100")
+		if keyword != "" {
+			q.Set("keyword", keyword)
+		}
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+
+		var resp listIncidentWorkflowActionsResponse
+		if err := doAPIRequest(ctx, client, "/incident_workflows/actions?"+q.Encode(), &resp); err != nil {
+			return nil, err
+		}
+		actions = append(actions, resp.Actions...)
+
+		if resp.NextCursor == "" {
+			return actions, nil
+		}
+		cursor = resp.NextCursor
+	}
+}
+
+func flattenIncidentWorkflowActions(actions []*incidentWorkflowAction) []interface{} {
+	result := make([]interface{}, 0, len(actions))
+
+	for _, a := range actions {
+		inputs := make([]interface{}, 0, len(a.Inputs))
+		for _, in := range a.Inputs {
+			inputs = append(inputs, map[string]interface{}{
+				"name":           in.Name,
+				"parameter_type": in.ParameterType,
+				"description":    in.Description,
+				"required":       in.IsRequired,
+				"default_value":  flattenIncidentWorkflowActionDefaultValue(in.DefaultValue),
+			})
+		}
+
+		outputs := make([]interface{}, 0, len(a.Outputs))
+		for _, out := range a.Outputs {
+			outputs = append(outputs, map[string]interface{}{
+				"name":           out.Name,
+				"parameter_type": out.ParameterType,
+				"description":    out.Description,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":          a.ID,
+			"name":        a.Name,
+			"description": a.Description,
+			"domain":      a.Domain,
+			"inputs":      inputs,
+			"outputs":     outputs,
+		})
+	}
+
+	return result
+}
+
+// flattenIncidentWorkflowActionDefaultValue returns the default value of an
+// action input as a string, the way input values are set on workflow steps.
+func flattenIncidentWorkflowActionDefaultValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
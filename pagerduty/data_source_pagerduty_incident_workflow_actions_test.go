@@ -0,0 +1,89 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyIncidentWorkflowActions(t *testing.T) {
+	dataSourceName := "data.pagerduty_incident_workflow_actions.status_update"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentWorkflows(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIncidentWorkflowActionsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "actions.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "actions.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "actions.0.inputs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyIncidentWorkflowActionsConfig() string {
+	return `
+data "pagerduty_incident_workflow_actions" "status_update" {
+  keyword = "status update"
+}
+`
+}
+
+func TestListIncidentWorkflowActions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token token=foo" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"Unauthorized","code":2006}}`))
+			return
+		}
+
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"actions":[{"id":"pagerduty.com:incident-workflows:send-status-update:1","name":"Send Status Update","inputs":[{"name":"Message","parameter_type":"text","is_required":true}]}],"next_cursor":"next"}`))
+		case "next":
+			_, _ = w.Write([]byte(`{"actions":[{"id":"pagerduty.com:incident-workflows:add-conference-bridge:5","name":"Add Conference Bridge","inputs":[{"name":"Overwrite existing","parameter_type":"boolean","default_value":false}]}],"next_cursor":null}`))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := pagerduty.NewClient(&pagerduty.Config{BaseURL: srv.URL, Token: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions, err := listIncidentWorkflowActions(context.Background(), client, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 actions, got %d", len(actions))
+	}
+
+	flattened := flattenIncidentWorkflowActions(actions)
+	input := flattened[0].(map[string]interface{})["inputs"].([]interface{})[0].(map[string]interface{})
+	if input["name"] != "Message" || input["required"] != true {
+		t.Errorf("unexpected input %v", input)
+	}
+	input = flattened[1].(map[string]interface{})["inputs"].([]interface{})[0].(map[string]interface{})
+	if input["default_value"] != "false" {
+		t.Errorf("expected default value %q, got %q", "false", input["default_value"])
+	}
+
+	client.Config.Token = "bar"
+	_, err = listIncidentWorkflowActions(context.Background(), client, "")
+	if !isErrCode(err, http.StatusUnauthorized) {
+		t.Errorf("expected unauthorized client error, got %v", err)
+	}
+}
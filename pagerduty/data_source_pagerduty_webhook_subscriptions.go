@@ -0,0 +1,145 @@
+package pagerduty
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyWebhookSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyWebhookSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"webhook_subscriptions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of every webhook subscription in the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"delivery_method": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"temporarily_disabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyWebhookSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty webhook subscriptions")
+
+	var pdWebhooks []*pagerduty.WebhookSubscription
+	err = retry.Retry(5*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.WebhookSubscriptions.List()
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return retry.RetryableError(err)
+		}
+
+		pdWebhooks = resp.WebhookSubscriptions
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	webhooks := make([]map[string]interface{}, 0, len(pdWebhooks))
+	for _, webhook := range pdWebhooks {
+		webhooks = append(webhooks, map[string]interface{}{
+			"id":          webhook.ID,
+			"type":        webhook.Type,
+			"active":      webhook.Active,
+			"description": webhook.Description,
+			"events":      flattenConfigList(webhook.Events),
+			"delivery_method": []map[string]interface{}{
+				{
+					"temporarily_disabled": webhook.DeliveryMethod.TemporarilyDisabled,
+					"type":                 webhook.DeliveryMethod.Type,
+					"url":                  webhook.DeliveryMethod.URL,
+				},
+			},
+			"filter": flattenFilter(webhook.Filter),
+		})
+	}
+
+	// Since this data doesn't have a unique ID, this force this data to be
+	// refreshed in every Terraform apply
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("webhook_subscriptions", webhooks)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyWebhookSubscriptions_Basic(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyWebhookSubscriptionsConfig(description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.pagerduty_webhook_subscriptions.all", "webhook_subscriptions.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.pagerduty_webhook_subscriptions.all",
+						"webhook_subscriptions.*",
+						map[string]string{
+							"description":            description,
+							"active":                 "true",
+							"events.#":               "1",
+							"events.0":               "incident.triggered",
+							"delivery_method.0.url":  "https://example.com/receive_a_pagerduty_webhook",
+							"delivery_method.0.type": "http_delivery_method",
+							"filter.0.type":          "account_reference",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyWebhookSubscriptionsConfig(description string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    type = "http_delivery_method"
+    url  = "https://example.com/receive_a_pagerduty_webhook"
+  }
+  description = "%s"
+  events      = ["incident.triggered"]
+  active      = true
+  filter {
+    type = "account_reference"
+  }
+}
+
+data "pagerduty_webhook_subscriptions" "all" {
+  depends_on = [pagerduty_webhook_subscription.foo]
+}
+`, description)
+}
@@ -79,6 +79,19 @@ var eventOrchestrationIncidentCustomFieldsObjectSchema = map[string]*schema.Sche
 	},
 }
 
+var eventOrchestrationPagerDutyAutomationActionSchema = map[string]*schema.Schema{
+	"action_id": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"trigger_types": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+}
+
 var eventOrchestrationAutomationActionSchema = map[string]*schema.Schema{
 	"name": {
 		Type:     schema.TypeString,
@@ -338,6 +351,22 @@ func expandEventOrchestrationPathAutomationActions(v interface{}) []*pagerduty.E
 	return result
 }
 
+func expandEventOrchestrationPathPagerDutyAutomationActions(v interface{}) []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction {
+	result := []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction{}
+
+	for _, i := range v.([]interface{}) {
+		a := i.(map[string]interface{})
+		pdaa := &pagerduty.EventOrchestrationPathPagerdutyAutomationAction{
+			ActionId:     a["action_id"].(string),
+			TriggerTypes: expandEventOrchestrationAutomationTriggerTypes(a["trigger_types"]),
+		}
+
+		result = append(result, pdaa)
+	}
+
+	return result
+}
+
 func expandEventOrchestrationAutomationActionObjects(v interface{}) []*pagerduty.EventOrchestrationPathAutomationActionObject {
 	result := []*pagerduty.EventOrchestrationPathAutomationActionObject{}
 
@@ -405,6 +434,21 @@ func flattenEventOrchestrationAutomationActions(v []*pagerduty.EventOrchestratio
 	return result
 }
 
+func flattenEventOrchestrationPagerDutyAutomationActions(v []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction) []interface{} {
+	var result []interface{}
+
+	for _, i := range v {
+		pdaa := map[string]interface{}{
+			"action_id":     i.ActionId,
+			"trigger_types": i.TriggerTypes,
+		}
+
+		result = append(result, pdaa)
+	}
+
+	return result
+}
+
 func flattenEventOrchestrationAutomationActionObjects(v []*pagerduty.EventOrchestrationPathAutomationActionObject) []interface{} {
 	var result []interface{}
 
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 	"github.com/heimweh/go-pagerduty/persistentconfig"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 )
 
 const (
@@ -25,9 +27,9 @@ func Provider(isMux bool) *schema.Provider {
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"skip_credentials_validation": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PAGERDUTY_SKIP_CREDENTIALS_VALIDATION", false),
 			},
 
 			"token": {
@@ -74,9 +76,9 @@ func Provider(isMux bool) *schema.Provider {
 			},
 
 			"api_url_override": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PAGERDUTY_API_URL_OVERRIDE", ""),
 			},
 
 			"insecure_tls": {
@@ -84,6 +86,18 @@ func Provider(isMux bool) *schema.Provider {
 				Optional: true,
 				Default:  false,
 			},
+
+			"skip_unavailable_features": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"preflight_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -105,9 +119,11 @@ func Provider(isMux bool) *schema.Provider {
 			"pagerduty_event_orchestrations":                       dataSourcePagerDutyEventOrchestrations(),
 			"pagerduty_incident_custom_field":                      dataSourcePagerDutyIncidentCustomField(),
 			"pagerduty_incident_workflow":                          dataSourcePagerDutyIncidentWorkflow(),
+			"pagerduty_incident_workflow_actions":                  dataSourcePagerDutyIncidentWorkflowActions(),
 			"pagerduty_priority":                                   dataSourcePagerDutyPriority(),
 			"pagerduty_ruleset":                                    dataSourcePagerDutyRuleset(),
 			"pagerduty_team_members":                               dataSourcePagerDutyTeamMembers(),
+			"pagerduty_webhook_subscriptions":                      dataSourcePagerDutyWebhookSubscriptions(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -127,6 +143,7 @@ func Provider(isMux bool) *schema.Provider {
 			"pagerduty_business_service":                              resourcePagerDutyBusinessService(),
 			"pagerduty_response_play":                                 resourcePagerDutyResponsePlay(),
 			"pagerduty_service_event_rule":                            resourcePagerDutyServiceEventRule(),
+			"pagerduty_service_event_rule_order":                      resourcePagerDutyServiceEventRuleOrder(),
 			"pagerduty_slack_connection":                              resourcePagerDutySlackConnection(),
 			"pagerduty_business_service_subscriber":                   resourcePagerDutyBusinessServiceSubscriber(),
 			"pagerduty_webhook_subscription":                          resourcePagerDutyWebhookSubscription(),
@@ -166,6 +183,10 @@ func Provider(isMux bool) *schema.Provider {
 		delete(p.ResourcesMap, "pagerduty_user_contact_method")
 	}
 
+	for resourceType, r := range p.ResourcesMap {
+		r.CustomizeDiff = preflightPermissionsCustomizeDiff(resourceType, r.Schema, r.CustomizeDiff)
+	}
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -179,6 +200,35 @@ func Provider(isMux bool) *schema.Provider {
 	return p
 }
 
+// preflightPermissionsCustomizeDiff runs the given CustomizeDiff after
+// checking the token can manage resourceType, when the provider was configured
+// with preflight_permissions. This makes plans fail before anything is
+// changed instead of in the middle of an apply. Resources planned without
+// changes aren't checked.
+func preflightPermissionsCustomizeDiff(resourceType string, resourceSchema map[string]*schema.Schema, next schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	keys := make([]string, 0, len(resourceSchema))
+	for k := range resourceSchema {
+		keys = append(keys, k)
+	}
+
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if c, ok := meta.(*Config); ok && c.PreflightPermissions && (d.Id() == "" || d.HasChanges(keys...)) {
+			permissions, err := c.TokenPermissions(ctx)
+			if err != nil {
+				return err
+			}
+			if err := permissions.Check(resourceType); err != nil {
+				return err
+			}
+		}
+
+		if next != nil {
+			return next(ctx, d, meta)
+		}
+		return nil
+	}
+}
+
 func isErrCode(err error, code int) bool {
 	if e, ok := err.(*pagerduty.Error); ok && e.ErrorResponse.Response.StatusCode == code {
 		return true
@@ -233,6 +283,27 @@ func handleNotFoundError(err error, d *schema.ResourceData) error {
 	return genError(err, d)
 }
 
+// handlePlanGatedFeatureError replaces a 402 Payment Required error with one
+// naming the feature missing from the account plan and the resource that
+// needed it. Any other error is returned unchanged.
+func handlePlanGatedFeatureError(err error, d *schema.ResourceData, resourceType string, feature util.PlanGatedFeature) error {
+	if !util.IsPlanGatedFeatureError(err) {
+		return err
+	}
+	return feature.Error(resourceType, d.Id(), err)
+}
+
+// skipPlanGatedFeatureRead reports whether a failed read should leave the
+// resource with its last known state because the feature isn't included in the
+// account plan and the provider was configured with skip_unavailable_features.
+func skipPlanGatedFeatureRead(err error, d *schema.ResourceData, meta interface{}, resourceType string) bool {
+	if !meta.(*Config).SkipUnavailableFeatures || !util.IsPlanGatedFeatureError(err) {
+		return false
+	}
+	log.Printf("[WARN] Keeping last known state of %s %s because its feature is not included in the account plan: %s", resourceType, d.Id(), err)
+	return true
+}
+
 func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	serviceRegion := strings.ToLower(data.Get("service_region").(string))
@@ -245,15 +316,17 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 	}
 
 	config := Config{
-		ApiUrl:              "https://api." + regionApiUrl + "pagerduty.com",
-		AppUrl:              "https://app." + regionApiUrl + "pagerduty.com",
-		SkipCredsValidation: data.Get("skip_credentials_validation").(bool),
-		Token:               data.Get("token").(string),
-		UserToken:           data.Get("user_token").(string),
-		UserAgent:           fmt.Sprintf("(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, terraformVersion),
-		ApiUrlOverride:      data.Get("api_url_override").(string),
-		ServiceRegion:       serviceRegion,
-		InsecureTls:         data.Get("insecure_tls").(bool),
+		ApiUrl:                  "https://api." + regionApiUrl + "pagerduty.com",
+		AppUrl:                  "https://app." + regionApiUrl + "pagerduty.com",
+		SkipCredsValidation:     data.Get("skip_credentials_validation").(bool),
+		Token:                   data.Get("token").(string),
+		UserToken:               data.Get("user_token").(string),
+		UserAgent:               fmt.Sprintf("(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, terraformVersion),
+		ApiUrlOverride:          data.Get("api_url_override").(string),
+		ServiceRegion:           serviceRegion,
+		InsecureTls:             data.Get("insecure_tls").(bool),
+		SkipUnavailableFeatures: data.Get("skip_unavailable_features").(bool),
+		PreflightPermissions:    data.Get("preflight_permissions").(bool),
 	}
 
 	useAuthTokenType := pagerduty.AuthTokenTypeAPIToken
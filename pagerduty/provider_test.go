@@ -1,14 +1,19 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -41,6 +46,138 @@ func TestProviderImpl(t *testing.T) {
 	var _ *schema.Provider = Provider(IsNotMuxed)
 }
 
+func TestProviderMockEndpointEnvDefaults(t *testing.T) {
+	t.Setenv("PAGERDUTY_API_URL_OVERRIDE", "http://localhost:8080")
+	t.Setenv("PAGERDUTY_SKIP_CREDENTIALS_VALIDATION", "true")
+
+	p := Provider(IsNotMuxed)
+	apiURLOverride, err := p.Schema["api_url_override"].DefaultValue()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if apiURLOverride != "http://localhost:8080" {
+		t.Fatalf("expected api_url_override to be sourced from environment, got %v", apiURLOverride)
+	}
+
+	skipCredentialsValidation, err := p.Schema["skip_credentials_validation"].DefaultValue()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if skipCredentialsValidation != "true" {
+		t.Fatalf("expected skip_credentials_validation to be sourced from environment, got %v", skipCredentialsValidation)
+	}
+}
+
+func TestPreflightPermissionsCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name      string
+		preflight bool
+		status    int
+		body      string
+		// existingName is the name of the service in state, if it exists.
+		// The configured name is always "foo".
+		existingName string
+		wantErr      string
+	}{
+		{
+			name:      "admin",
+			preflight: true,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"admin"}}`,
+		},
+		{
+			name:      "read-only user",
+			preflight: true,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"read_only_user"}}`,
+			wantErr:   `"read_only_user" role, which can't manage any resource`,
+		},
+		{
+			name:         "read-only user updating",
+			preflight:    true,
+			status:       http.StatusOK,
+			body:         `{"user":{"id":"PUSER01","role":"read_only_user"}}`,
+			existingName: "bar",
+			wantErr:      `"read_only_user" role, which can't manage any resource`,
+		},
+		{
+			name:         "read-only user without changes",
+			preflight:    true,
+			status:       http.StatusOK,
+			body:         `{"user":{"id":"PUSER01","role":"read_only_user"}}`,
+			existingName: "foo",
+		},
+		{
+			name:      "read-only user without preflight",
+			preflight: false,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"read_only_user"}}`,
+		},
+		{
+			name:      "account level token",
+			preflight: true,
+			status:    http.StatusBadRequest,
+			body:      `{"error":{"message":"Invalid Input Provided","code":2001,"errors":["This endpoint can only be used with a user-level API key"]}}`,
+		},
+		{
+			name:      "lookup failure",
+			preflight: true,
+			status:    http.StatusInternalServerError,
+			body:      `{"error":{"message":"Internal Server Error","code":2000}}`,
+			wantErr:   "failed to look up the permissions of the API token",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/users/me" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			}))
+			defer srv.Close()
+
+			config := &Config{ApiUrl: srv.URL, Token: "foo", SkipCredsValidation: true, PreflightPermissions: c.preflight}
+			nextCalled := false
+			next := func(context.Context, *schema.ResourceDiff, interface{}) error {
+				nextCalled = true
+				return nil
+			}
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true},
+				},
+			}
+			r.CustomizeDiff = preflightPermissionsCustomizeDiff("pagerduty_service", r.Schema, next)
+
+			var state *sdkterraform.InstanceState
+			if c.existingName != "" {
+				state = &sdkterraform.InstanceState{ID: "PSVC01", Attributes: map[string]string{"id": "PSVC01", "name": c.existingName}}
+			}
+			_, err := r.SimpleDiff(context.Background(), state, sdkterraform.NewResourceConfigRaw(map[string]interface{}{"name": "foo"}), config)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !nextCalled {
+					t.Error("expected the resource CustomizeDiff to be called")
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+			}
+			if nextCalled {
+				t.Error("expected the resource CustomizeDiff not to be called")
+			}
+		})
+	}
+}
+
 func TestAccPagerDutyProviderAuthMethods_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
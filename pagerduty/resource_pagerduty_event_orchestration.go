@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyEventOrchestration() *schema.Resource {
@@ -109,6 +111,9 @@ func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta inte
 
 	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
 		if orch, _, err := client.EventOrchestrations.Create(payload); err != nil {
+			if isErrCode(err, http.StatusPaymentRequired) {
+				return retry.NonRetryableError(err)
+			}
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return retry.RetryableError(err)
 			}
@@ -122,7 +127,7 @@ func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta inte
 	})
 
 	if retryErr != nil {
-		return retryErr
+		return handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration", util.FeatureEventOrchestration)
 	}
 
 	setEventOrchestrationProps(d, orchestration)
@@ -136,10 +141,10 @@ func resourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
 		orch, _, err := client.EventOrchestrations.Get(d.Id())
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -156,6 +161,11 @@ func resourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interf
 
 		return nil
 	})
+	if skipPlanGatedFeatureRead(retryErr, d, meta, "pagerduty_event_orchestration") {
+		return nil
+	}
+
+	return handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration", util.FeatureEventOrchestration)
 }
 
 func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -170,6 +180,9 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 
 	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
 		if _, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
+			if isErrCode(err, http.StatusPaymentRequired) {
+				return retry.NonRetryableError(err)
+			}
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return retry.RetryableError(err)
 			}
@@ -180,7 +193,7 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 	})
 
 	if retryErr != nil {
-		return retryErr
+		return handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration", util.FeatureEventOrchestration)
 	}
 
 	return nil
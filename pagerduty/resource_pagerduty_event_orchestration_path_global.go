@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 )
 
 func customizeDiffGlobalOrchestration(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
@@ -55,6 +57,14 @@ var eventOrchestrationPathGlobalCatchAllActionsSchema = map[string]*schema.Schem
 		Type:     schema.TypeString,
 		Optional: true,
 	},
+	"pagerduty_automation_action": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: eventOrchestrationPagerDutyAutomationActionSchema,
+		},
+	},
 	"automation_action": {
 		Type:     schema.TypeList,
 		Optional: true,
@@ -209,7 +219,7 @@ func resourcePagerDutyEventOrchestrationPathGlobalRead(ctx context.Context, d *s
 		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for orchestration: %s", t, id)
 
 		if path, _, err := client.EventOrchestrationPaths.GetContext(ctx, d.Id(), t); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -222,7 +232,10 @@ func resourcePagerDutyEventOrchestrationPathGlobalRead(ctx context.Context, d *s
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		if skipPlanGatedFeatureRead(retryErr, d, meta, "pagerduty_event_orchestration_global") {
+			return diags
+		}
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_global", util.FeatureEventOrchestration))
 	}
 
 	return diags
@@ -257,7 +270,7 @@ func resourcePagerDutyEventOrchestrationPathGlobalUpdate(ctx context.Context, d
 
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		if response, _, err := client.EventOrchestrationPaths.UpdateContext(ctx, payload.Parent.ID, "global", payload); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -271,7 +284,7 @@ func resourcePagerDutyEventOrchestrationPathGlobalUpdate(ctx context.Context, d
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_global", util.FeatureEventOrchestration))
 	}
 
 	setEventOrchestrationPathGlobalProps(d, globalPath)
@@ -294,7 +307,7 @@ func resourcePagerDutyEventOrchestrationPathGlobalDelete(ctx context.Context, d
 
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		if _, _, err := client.EventOrchestrationPaths.UpdateContext(ctx, orchestrationID, "global", emptyPath); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -392,6 +405,7 @@ func expandGlobalPathCatchAll(v interface{}) *pagerduty.EventOrchestrationPathCa
 
 func expandGlobalPathActions(v interface{}) *pagerduty.EventOrchestrationPathRuleActions {
 	var actions = &pagerduty.EventOrchestrationPathRuleActions{
+		PagerdutyAutomationActions: []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction{},
 		AutomationActions:          []*pagerduty.EventOrchestrationPathAutomationAction{},
 		Variables:                  []*pagerduty.EventOrchestrationPathActionVariables{},
 		Extractions:                []*pagerduty.EventOrchestrationPathActionExtractions{},
@@ -413,6 +427,7 @@ func expandGlobalPathActions(v interface{}) *pagerduty.EventOrchestrationPathRul
 		actions.Annotate = a["annotate"].(string)
 		actions.Severity = a["severity"].(string)
 		actions.EventAction = a["event_action"].(string)
+		actions.PagerdutyAutomationActions = expandEventOrchestrationPathPagerDutyAutomationActions(a["pagerduty_automation_action"])
 		actions.AutomationActions = expandEventOrchestrationPathAutomationActions(a["automation_action"])
 		actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
 		actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
@@ -492,6 +507,9 @@ func flattenGlobalPathActions(actions *pagerduty.EventOrchestrationPathRuleActio
 	if actions.Extractions != nil {
 		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
 	}
+	if actions.PagerdutyAutomationActions != nil {
+		flattenedAction["pagerduty_automation_action"] = flattenEventOrchestrationPagerDutyAutomationActions(actions.PagerdutyAutomationActions)
+	}
 	if actions.AutomationActions != nil {
 		flattenedAction["automation_action"] = flattenEventOrchestrationAutomationActions(actions.AutomationActions)
 	}
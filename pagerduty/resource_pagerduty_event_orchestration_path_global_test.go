@@ -139,6 +139,9 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 							resource.TestCheckResourceAttr(
 								res, "set.0.rule.0.actions.0.escalation_policy", "POLICY3",
 							),
+							resource.TestCheckResourceAttr(
+								res, "set.0.rule.0.actions.0.pagerduty_automation_action.0.action_id", "01CSB5SMOKCKVRI5GN0LJG7SMBUPDATED",
+							),
 						}...,
 					)...,
 				),
@@ -574,6 +577,10 @@ func testAccCheckPagerDutyEventOrchestrationPathGlobalAllActionsConfig(t, ep, s,
 						priority = "P0IN2KQ"
 						escalation_policy = pagerduty_escalation_policy.foo.id
 						annotate = "Routed through an event orchestration"
+						pagerduty_automation_action {
+							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMB"
+							trigger_types = ["alert_suppressed"]
+						}
 						severity = "critical"
 						event_action = "trigger"
 						variable {
@@ -684,6 +691,10 @@ func testAccCheckPagerDutyEventOrchestrationPathGlobalAllActionsUpdateConfig(t,
 						priority = "P0IN2KR"
 						escalation_policy = "POLICY3"
 						annotate = "Routed through a service orchestration!"
+						pagerduty_automation_action {
+							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMBUPDATED"
+							trigger_types = ["alert_suspended"]
+						}
 						severity = "warning"
 						event_action = "resolve"
 						variable {
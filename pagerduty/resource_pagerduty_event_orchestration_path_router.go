@@ -234,7 +234,7 @@ func resourcePagerDutyEventOrchestrationPathRouterRead(ctx context.Context, d *s
 		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for orchestration: %s", "router", d.Id())
 
 		if routerPath, _, err := client.EventOrchestrationPaths.GetContext(ctx, d.Id(), "router"); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -255,7 +255,10 @@ func resourcePagerDutyEventOrchestrationPathRouterRead(ctx context.Context, d *s
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		if skipPlanGatedFeatureRead(retryErr, d, meta, "pagerduty_event_orchestration_router") {
+			return diags
+		}
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_router", util.FeatureEventOrchestration))
 	}
 
 	return diags
@@ -290,7 +293,7 @@ func resourcePagerDutyEventOrchestrationPathRouterDelete(ctx context.Context, d
 
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		if _, _, err := client.EventOrchestrationPaths.UpdateContext(ctx, routerID, "router", emptyPath); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -326,7 +329,7 @@ func resourcePagerDutyEventOrchestrationPathRouterUpdate(ctx context.Context, d
 			if util.IsDefaultMobilizationServiceError(err) {
 				return retry.NonRetryableError(util.DMSMsgOrchestrationRouter.Error(err))
 			}
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -350,7 +353,7 @@ func resourcePagerDutyEventOrchestrationPathRouterUpdate(ctx context.Context, d
 
 	if retryErr != nil {
 		time.Sleep(2 * time.Second)
-		return diag.FromErr(retryErr)
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_router", util.FeatureEventOrchestration))
 	}
 
 	return convertEventOrchestrationPathWarningsToDiagnostics(warnings, diags)
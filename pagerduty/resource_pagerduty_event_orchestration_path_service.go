@@ -37,18 +37,7 @@ func buildEventOrchestrationPathServiceCatchAllActionsSchema() map[string]*schem
 			Optional: true,
 			MaxItems: 1,
 			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"action_id": {
-						Type:     schema.TypeString,
-						Required: true,
-					},
-					"trigger_types": {
-						Type:     schema.TypeList,
-						Optional: true,
-						MaxItems: 1,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-				},
+				Schema: eventOrchestrationPagerDutyAutomationActionSchema,
 			},
 		},
 		"automation_action": {
@@ -236,7 +225,7 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(ctx context.Context, d *
 		path, _, err = client.EventOrchestrationPaths.GetContext(ctx, id, t)
 
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -256,7 +245,10 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(ctx context.Context, d *
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		if skipPlanGatedFeatureRead(retryErr, d, meta, "pagerduty_event_orchestration_service") {
+			return nil
+		}
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_service", util.FeatureEventOrchestration))
 	}
 
 	serviceID := d.Get("service").(string)
@@ -271,7 +263,7 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(ctx context.Context, d *
 				return nil
 			}
 			if err != nil {
-				if isErrCode(err, http.StatusBadRequest) {
+				if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 					return retry.NonRetryableError(err)
 				}
 
@@ -327,7 +319,7 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(ctx context.Context, d
 			if util.IsDefaultMobilizationServiceError(err) {
 				return retry.NonRetryableError(util.DMSMsgOrchestrationService.Error(err))
 			}
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -341,7 +333,7 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(ctx context.Context, d
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_service", util.FeatureEventOrchestration))
 	}
 
 	setEventOrchestrationPathServiceProps(d, servicePath)
@@ -356,7 +348,7 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(ctx context.Context, d
 				return nil
 			}
 			if err != nil {
-				if isErrCode(err, http.StatusBadRequest) {
+				if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 					return retry.NonRetryableError(err)
 				}
 
@@ -418,7 +410,7 @@ func resourcePagerDutyEventOrchestrationPathServiceDelete(ctx context.Context, d
 
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		if _, _, err := client.EventOrchestrationPaths.UpdateContext(ctx, serviceID, "service", emptyPath); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -537,7 +529,7 @@ func expandServicePathActions(v interface{}) *pagerduty.EventOrchestrationPathRu
 		actions.Annotate = a["annotate"].(string)
 		actions.Severity = a["severity"].(string)
 		actions.EventAction = a["event_action"].(string)
-		actions.PagerdutyAutomationActions = expandServicePathPagerDutyAutomationActions(a["pagerduty_automation_action"])
+		actions.PagerdutyAutomationActions = expandEventOrchestrationPathPagerDutyAutomationActions(a["pagerduty_automation_action"])
 		actions.AutomationActions = expandEventOrchestrationPathAutomationActions(a["automation_action"])
 		actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
 		actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
@@ -547,22 +539,6 @@ func expandServicePathActions(v interface{}) *pagerduty.EventOrchestrationPathRu
 	return actions
 }
 
-func expandServicePathPagerDutyAutomationActions(v interface{}) []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction {
-	result := []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction{}
-
-	for _, i := range v.([]interface{}) {
-		a := i.(map[string]interface{})
-		pdaa := &pagerduty.EventOrchestrationPathPagerdutyAutomationAction{
-			ActionId:     a["action_id"].(string),
-			TriggerTypes: expandEventOrchestrationAutomationTriggerTypes(a["trigger_types"]),
-		}
-
-		result = append(result, pdaa)
-	}
-
-	return result
-}
-
 func setEventOrchestrationPathServiceProps(d *schema.ResourceData, p *pagerduty.EventOrchestrationPath) error {
 	d.SetId(p.Parent.ID)
 	d.Set("service", p.Parent.ID)
@@ -633,7 +609,7 @@ func flattenServicePathActions(actions *pagerduty.EventOrchestrationPathRuleActi
 		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
 	}
 	if actions.PagerdutyAutomationActions != nil {
-		flattenedAction["pagerduty_automation_action"] = flattenServicePathPagerDutyAutomationActions(actions.PagerdutyAutomationActions)
+		flattenedAction["pagerduty_automation_action"] = flattenEventOrchestrationPagerDutyAutomationActions(actions.PagerdutyAutomationActions)
 	}
 	if actions.AutomationActions != nil {
 		flattenedAction["automation_action"] = flattenEventOrchestrationAutomationActions(actions.AutomationActions)
@@ -646,18 +622,3 @@ func flattenServicePathActions(actions *pagerduty.EventOrchestrationPathRuleActi
 
 	return actionsMap
 }
-
-func flattenServicePathPagerDutyAutomationActions(v []*pagerduty.EventOrchestrationPathPagerdutyAutomationAction) []interface{} {
-	var result []interface{}
-
-	for _, i := range v {
-		pdaa := map[string]interface{}{
-			"action_id":     i.ActionId,
-			"trigger_types": i.TriggerTypes,
-		}
-
-		result = append(result, pdaa)
-	}
-
-	return result
-}
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 )
 
 func customizeDiffUnroutedOrchestration(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
@@ -197,7 +199,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedRead(ctx context.Context, d
 		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type: %s for orchestration: %s", "unrouted", d.Id())
 
 		if unroutedPath, _, err := client.EventOrchestrationPaths.GetContext(ctx, d.Id(), "unrouted"); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -216,7 +218,10 @@ func resourcePagerDutyEventOrchestrationPathUnroutedRead(ctx context.Context, d
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		if skipPlanGatedFeatureRead(retryErr, d, meta, "pagerduty_event_orchestration_unrouted") {
+			return diags
+		}
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_unrouted", util.FeatureEventOrchestration))
 	}
 
 	return diags
@@ -252,7 +257,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedDelete(ctx context.Context,
 
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		if _, _, err := client.EventOrchestrationPaths.UpdateContext(ctx, orchestrationID, "unrouted", emptyPath); err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -285,7 +290,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedUpdate(ctx context.Context,
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		response, _, err := client.EventOrchestrationPaths.UpdateContext(ctx, unroutedPath.Parent.ID, "unrouted", unroutedPath)
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusPaymentRequired) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -313,7 +318,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedUpdate(ctx context.Context,
 
 	if retryErr != nil {
 		time.Sleep(2 * time.Second)
-		return diag.FromErr(retryErr)
+		return diag.FromErr(handlePlanGatedFeatureError(retryErr, d, "pagerduty_event_orchestration_unrouted", util.FeatureEventOrchestration))
 	}
 
 	return convertEventOrchestrationPathWarningsToDiagnostics(warnings, diags)
@@ -2,9 +2,11 @@ package pagerduty
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -326,7 +328,11 @@ func customizeIncidentWorkflowDiff() schema.CustomizeDiffFunc {
 		}
 	}
 
-	return func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := validateIncidentWorkflowStepInputsDiff(ctx, d, meta); err != nil {
+			return err
+		}
+
 		id := d.Id()
 		if id != "" {
 			keys := d.GetChangedKeysPrefix("step")
@@ -359,6 +365,86 @@ func customizeIncidentWorkflowDiff() schema.CustomizeDiffFunc {
 	}
 }
 
+// validateIncidentWorkflowStepInputsDiff checks the inputs of the steps against
+// the inputs of their action at plan time. Steps whose action is not known yet
+// or not in the action catalog are not checked, and neither are the steps
+// when the catalog can't be listed.
+func validateIncidentWorkflowStepInputsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("step") || !d.NewValueKnown("step") {
+		return nil
+	}
+	steps := d.Get("step").([]interface{})
+	if len(steps) == 0 {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	actions, err := listIncidentWorkflowActions(ctx, client, "")
+	if err != nil {
+		log.Printf("[WARN] Not validating incident workflow step inputs, listing the actions failed: %s", err)
+		return nil
+	}
+
+	return validateIncidentWorkflowStepInputs(steps, actions)
+}
+
+func validateIncidentWorkflowStepInputs(steps []interface{}, actions []*incidentWorkflowAction) error {
+	actionsByID := make(map[string]*incidentWorkflowAction, len(actions))
+	for _, a := range actions {
+		actionsByID[a.ID] = a
+	}
+
+	var errs []string
+	for i, s := range steps {
+		step, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		action, ok := actionsByID[step["action"].(string)]
+		if !ok || len(action.Inputs) == 0 {
+			continue
+		}
+
+		configured := map[string]bool{}
+		for _, key := range []string{"input", "inline_steps_input"} {
+			inputs, _ := step[key].([]interface{})
+			for _, in := range inputs {
+				if in, ok := in.(map[string]interface{}); ok {
+					configured[in["name"].(string)] = true
+				}
+			}
+		}
+
+		known := map[string]bool{}
+		for _, in := range action.Inputs {
+			known[in.Name] = true
+			if in.IsRequired && in.DefaultValue == nil && !configured[in.Name] {
+				errs = append(errs, fmt.Sprintf("step.%d (%s): input %q is required by action %s", i, step["name"], in.Name, action.ID))
+			}
+		}
+
+		names := make([]string, 0, len(configured))
+		for name := range configured {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !known[name] {
+				errs = append(errs, fmt.Sprintf("step.%d (%s): action %s has no input %q", i, step["name"], action.ID, name))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("Invalid configuration: incident workflow step inputs don't match their action:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
 func resourcePagerDutyIncidentWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -825,3 +825,71 @@ func TestFlattenIncidentWorkflowSteps_Import(t *testing.T) {
 		t.Errorf("Expected 1 step in result, got %d", len(result))
 	}
 }
+
+func TestValidateIncidentWorkflowStepInputs(t *testing.T) {
+	actions := []*incidentWorkflowAction{
+		{
+			ID: "pagerduty.com:incident-workflows:send-status-update:1",
+			Inputs: []*incidentWorkflowActionParameter{
+				{Name: "Message", IsRequired: true},
+				{Name: "Status Update template ID"},
+			},
+		},
+		{
+			ID: "pagerduty.com:incident-workflows:add-conference-bridge:5",
+			Inputs: []*incidentWorkflowActionParameter{
+				{Name: "Overwrite existing", IsRequired: true, DefaultValue: false},
+			},
+		},
+	}
+	step := func(action string, inputs ...string) interface{} {
+		in := make([]interface{}, 0, len(inputs))
+		for _, name := range inputs {
+			in = append(in, map[string]interface{}{"name": name, "value": "foo"})
+		}
+		return map[string]interface{}{"name": "Step", "action": action, "input": in}
+	}
+
+	cases := []struct {
+		name    string
+		steps   []interface{}
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			steps: []interface{}{step("pagerduty.com:incident-workflows:send-status-update:1", "Message")},
+		},
+		{
+			name:  "required input with default value",
+			steps: []interface{}{step("pagerduty.com:incident-workflows:add-conference-bridge:5")},
+		},
+		{
+			name:  "action not in the catalog",
+			steps: []interface{}{step("pagerduty.com:incident-workflows:custom:1", "Anything")},
+		},
+		{
+			name:    "missing required input",
+			steps:   []interface{}{step("pagerduty.com:incident-workflows:send-status-update:1")},
+			wantErr: `input "Message" is required`,
+		},
+		{
+			name:    "unknown input",
+			steps:   []interface{}{step("pagerduty.com:incident-workflows:send-status-update:1", "Message", "Mesage")},
+			wantErr: `has no input "Mesage"`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateIncidentWorkflowStepInputs(c.steps, actions)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+			}
+		})
+	}
+}
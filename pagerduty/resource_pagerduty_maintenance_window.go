@@ -1,11 +1,14 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"time"
 
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -13,19 +16,21 @@ import (
 
 func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyMaintenanceWindowCreate,
-		Read:   resourcePagerDutyMaintenanceWindowRead,
-		Update: resourcePagerDutyMaintenanceWindowUpdate,
-		Delete: resourcePagerDutyMaintenanceWindowDelete,
+		CreateContext: resourcePagerDutyMaintenanceWindowCreate,
+		ReadContext:   resourcePagerDutyMaintenanceWindowRead,
+		UpdateContext: resourcePagerDutyMaintenanceWindowUpdate,
+		DeleteContext: resourcePagerDutyMaintenanceWindowDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"start_time": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateFunc:     validateRFC3339,
-				DiffSuppressFunc: suppressRFC3339Diff,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339,
+				// PagerDuty sets a start in the past to the current time, and the
+				// start can't be moved once the window began.
+				DiffSuppressFunc: suppressPastStartDiff,
 			},
 			"end_time": {
 				Type:             schema.TypeString,
@@ -37,6 +42,7 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 			"services": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MinItems: 1,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -64,10 +70,10 @@ func buildMaintenanceWindowStruct(d *schema.ResourceData) *pagerduty.Maintenance
 	return window
 }
 
-func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePagerDutyMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	window := buildMaintenanceWindowStruct(d)
@@ -77,9 +83,9 @@ func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta inter
 	window, _, err = client.MaintenanceWindows.Create(window)
 	if err != nil {
 		if util.IsDefaultMobilizationServiceError(err) {
-			return util.DMSMsgMaintenanceWindow.Error(err)
+			return diag.FromErr(util.DMSMsgMaintenanceWindow.Error(err))
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(window.ID)
@@ -87,15 +93,15 @@ func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interface{}) error {
+func resourcePagerDutyMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Reading PagerDuty maintenance window %s", d.Id())
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return diag.FromErr(retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		window, _, err := client.MaintenanceWindows.Get(d.Id())
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -120,33 +126,78 @@ func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interfa
 		}
 
 		return nil
-	})
+	}))
 }
 
-func resourcePagerDutyMaintenanceWindowUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourcePagerDutyMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	window := buildMaintenanceWindowStruct(d)
+	window := buildMaintenanceWindowUpdateStruct(d)
 
 	log.Printf("[INFO] Updating PagerDuty maintenance window %s", d.Id())
 
-	if _, _, err := client.MaintenanceWindows.Update(d.Id(), window); err != nil {
+	if err := updateMaintenanceWindow(ctx, client, d.Id(), window, d.HasChange("description")); err != nil {
 		if util.IsDefaultMobilizationServiceError(err) {
-			return util.DMSMsgMaintenanceWindow.Error(err)
+			return diag.FromErr(util.DMSMsgMaintenanceWindow.Error(err))
 		}
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyMaintenanceWindowRead(ctx, d, meta)
+}
+
+// buildMaintenanceWindowUpdateStruct only sets the changed fields, so the
+// services or the end of a window already in progress can be updated without
+// sending back its start time, which PagerDuty rejects once the window began.
+func buildMaintenanceWindowUpdateStruct(d *schema.ResourceData) *pagerduty.MaintenanceWindow {
+	window := &pagerduty.MaintenanceWindow{}
+
+	if d.HasChange("start_time") {
+		window.StartTime = d.Get("start_time").(string)
+	}
+	if d.HasChange("end_time") {
+		window.EndTime = d.Get("end_time").(string)
+	}
+	if d.HasChange("services") {
+		window.Services = expandServices(d.Get("services").(*schema.Set))
+	}
+	if d.HasChange("description") {
+		window.Description = d.Get("description").(string)
+	}
+
+	return window
+}
+
+// updateMaintenanceWindow updates the window through the client, unless the
+// description is cleared. The client leaves an empty description out of the
+// request, which would keep the previous one, so it's sent explicitly.
+func updateMaintenanceWindow(ctx context.Context, client *pagerduty.Client, id string, window *pagerduty.MaintenanceWindow, descriptionChanged bool) error {
+	if !descriptionChanged || window.Description != "" {
+		_, _, err := client.MaintenanceWindows.Update(id, window)
 		return err
 	}
 
-	return nil
+	b, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+	payload := map[string]interface{}{}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+	payload["description"] = ""
+
+	return doAPIRequestWithBody(ctx, client, http.MethodPut, "/maintenance_windows/"+id,
+		map[string]interface{}{"maintenance_window": payload}, nil)
 }
 
-func resourcePagerDutyMaintenanceWindowDelete(d *schema.ResourceData, meta interface{}) error {
+func resourcePagerDutyMaintenanceWindowDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting PagerDuty maintenance window %s", d.Id())
@@ -155,7 +206,7 @@ func resourcePagerDutyMaintenanceWindowDelete(d *schema.ResourceData, meta inter
 		// 405: The maintenance window can't be deleted because it has already ended. This can be considered deleted
 		// from terraform's perspective.
 		if !isErrCode(err, 405) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1,8 +1,12 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -70,6 +74,159 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyMaintenanceWindow_InProgressServicesUpdate(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(-1 * time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	var windowID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowEnded,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfig(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "services.#", "1"),
+					resource.TestCheckResourceAttrWith("pagerduty_maintenance_window.foo", "id", func(id string) error {
+						windowID = id
+						return nil
+					}),
+				),
+			},
+			// Adding a service to the window in progress updates it in place
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigUpdated(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "services.#", "2"),
+					resource.TestCheckResourceAttrWith("pagerduty_maintenance_window.foo", "id", func(id string) error {
+						if id != windowID {
+							return fmt.Errorf("expected maintenance window %s to be updated in place, got %s", windowID, id)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyMaintenanceWindow_ClearDescription(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfig(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "description", window),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigEmptyDescription(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressMaintenanceWindowStartDiff(t *testing.T) {
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	earlier := time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).Format(time.RFC3339)
+
+	cases := []struct {
+		name     string
+		old, new string
+		want     bool
+	}{
+		{name: "started window given another past start", old: past, new: earlier, want: true},
+		{name: "not yet started window given a past start", old: future, new: past, want: false},
+		{name: "started window given a future start", old: past, new: future, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := suppressPastStartDiff("start_time", c.old, c.new, nil); got != c.want {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateMaintenanceWindowClearDescription(t *testing.T) {
+	var body map[string]map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/maintenance_windows/PMW1234" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"maintenance_window":{"id":"PMW1234","description":""}}`))
+	}))
+	defer srv.Close()
+
+	client, err := pagerduty.NewClient(&pagerduty.Config{BaseURL: srv.URL, Token: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	window := &pagerduty.MaintenanceWindow{EndTime: "2026-10-16T10:00:00Z"}
+	if err := updateMaintenanceWindow(context.Background(), client, "PMW1234", window, true); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := body["maintenance_window"]
+	if v, ok := sent["description"]; !ok || v != "" {
+		t.Errorf("expected an empty description to be sent, got %v", sent)
+	}
+	if sent["end_time"] != "2026-10-16T10:00:00Z" {
+		t.Errorf("expected the changed end_time to be sent, got %v", sent)
+	}
+	if _, ok := sent["start_time"]; ok {
+		t.Errorf("expected the unchanged start_time not to be sent, got %v", sent)
+	}
+}
+
+// testAccCheckPagerDutyMaintenanceWindowEnded checks windows that were in
+// progress are over, since deleting such a window ends it instead.
+func testAccCheckPagerDutyMaintenanceWindowEnded(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_maintenance_window" {
+			continue
+		}
+
+		window, _, err := client.MaintenanceWindows.Get(r.Primary.ID)
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.RFC3339, window.EndTime)
+		if err != nil {
+			return err
+		}
+		if end.After(time.Now()) {
+			return fmt.Errorf("maintenance window %s is still in progress", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
 func testAccCheckPagerDutyMaintenanceWindowDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -159,6 +316,54 @@ resource "pagerduty_maintenance_window" "foo" {
 `, desc, start, end)
 }
 
+func testAccCheckPagerDutyMaintenanceWindowConfigEmptyDescription(name, start, end string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%[1]v"
+  email       = "%[1]v@foo.test"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%[1]v"
+  description = "bar"
+  num_loops   = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%[1]v"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  description = ""
+  start_time  = "%[2]v"
+  end_time    = "%[3]v"
+  services    = [pagerduty_service.foo.id]
+}
+`, name, start, end)
+}
+
 func testAccCheckPagerDutyMaintenanceWindowConfigUpdated(desc, start, end string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -0,0 +1,252 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// resourcePagerDutyServiceEventRuleOrder manages the ordering of every event
+// rule of a service authoritatively. The complete ordered list of rules is
+// reconciled on apply, and rules created or reordered outside of Terraform are
+// reported as drift.
+func resourcePagerDutyServiceEventRuleOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePagerDutyServiceEventRuleOrderCreate,
+		ReadContext:   resourcePagerDutyServiceEventRuleOrderRead,
+		UpdateContext: resourcePagerDutyServiceEventRuleOrderUpdate,
+		DeleteContext: resourcePagerDutyServiceEventRuleOrderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyServiceEventRuleOrderImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcePagerDutyServiceEventRuleOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	serviceID := d.Get("service").(string)
+
+	if err := reconcileServiceEventRuleOrder(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(serviceID)
+
+	return resourcePagerDutyServiceEventRuleOrderRead(ctx, d, meta)
+}
+
+func resourcePagerDutyServiceEventRuleOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceID := d.Id()
+	log.Printf("[INFO] Reading PagerDuty service event rule order for service: %s", serviceID)
+
+	var rules []*pagerduty.ServiceEventRule
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var err error
+		rules, err = listServiceEventRules(ctx, client, serviceID)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusUnauthorized) ||
+				isErrCode(err, http.StatusForbidden) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(handleNotFoundError(retryErr, d))
+	}
+
+	actual := sortServiceEventRuleIDsByPosition(rules)
+
+	var diags diag.Diagnostics
+	if managed := expandConfigList(d.Get("rules").([]interface{})); len(managed) > 0 {
+		if unmanaged := unmanagedServiceEventRuleIDs(managed, actual); len(unmanaged) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Service %s has event rules not managed by pagerduty_service_event_rule_order", serviceID),
+				Detail: fmt.Sprintf("The following event rules are not part of the configured order and will be reported as drift until "+
+					"they are added to `rules` or deleted: %s", strings.Join(unmanaged, ", ")),
+			})
+		}
+	}
+
+	d.Set("service", serviceID)
+	d.Set("rules", actual)
+
+	return diags
+}
+
+func resourcePagerDutyServiceEventRuleOrderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := reconcileServiceEventRuleOrder(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyServiceEventRuleOrderRead(ctx, d, meta)
+}
+
+func resourcePagerDutyServiceEventRuleOrderDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing PagerDuty service event rule order for service %s from state. Event rules keep their current position", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyServiceEventRuleOrderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	if _, err := listServiceEventRules(ctx, client, d.Id()); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	d.Set("service", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// reconcileServiceEventRuleOrder moves every configured rule to its index in
+// the configured list. Rules are placed from the first position onwards, so
+// moving a rule only shifts the ones not yet placed.
+func reconcileServiceEventRuleOrder(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service").(string)
+	ruleIDs := expandConfigList(d.Get("rules").([]interface{}))
+
+	seen := make(map[string]bool, len(ruleIDs))
+	for _, id := range ruleIDs {
+		if seen[id] {
+			return fmt.Errorf("Invalid configuration: event rule %s is listed more than once in rules", id)
+		}
+		seen[id] = true
+	}
+
+	for i, ruleID := range ruleIDs {
+		position := i
+		log.Printf("[INFO] Moving PagerDuty service event rule %s of service %s to position %d", ruleID, serviceID, position)
+
+		retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			rule, _, err := client.Services.GetEventRule(serviceID, ruleID)
+			if err != nil {
+				if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+					return retry.NonRetryableError(fmt.Errorf("Error reading event rule %s of service %s: %w", ruleID, serviceID, err))
+				}
+				return retry.RetryableError(err)
+			}
+			if rule.Position != nil && *rule.Position == position {
+				return nil
+			}
+
+			rule.Position = &position
+			updatedRule, _, err := client.Services.UpdateEventRule(serviceID, ruleID, rule)
+			if err != nil {
+				if isErrCode(err, http.StatusBadRequest) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			if updatedRule.Position == nil || *updatedRule.Position != position {
+				return retry.RetryableError(fmt.Errorf("Error updating service event rule %s position needs to be %d", ruleID, position))
+			}
+
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return nil
+}
+
+// listServiceEventRules lists every event rule of a service. The options of
+// client.Services.ListEventRules aren't encoded into the query string, so it
+// can only ever return the first page of rules.
+func listServiceEventRules(ctx context.Context, client *pagerduty.Client, serviceID string) ([]*pagerduty.ServiceEventRule, error) {
+	var rules []*pagerduty.ServiceEventRule
+
+	offset := 0
+	for {
+		q := url.Values{}
+		q.Set("limit", "100")
+		q.Set("offset", strconv.Itoa(offset))
+
+		var resp pagerduty.ListServiceEventRuleResponse
+		if err := doAPIRequest(ctx, client, fmt.Sprintf("/services/%s/rules?%s", serviceID, q.Encode()), &resp); err != nil {
+			return nil, err
+		}
+		rules = append(rules, resp.EventRules...)
+
+		if !resp.More || len(resp.EventRules) == 0 {
+			return rules, nil
+		}
+		offset += len(resp.EventRules)
+	}
+}
+
+func sortServiceEventRuleIDsByPosition(rules []*pagerduty.ServiceEventRule) []string {
+	sorted := make([]*pagerduty.ServiceEventRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position == nil || sorted[j].Position == nil {
+			return sorted[j].Position == nil && sorted[i].Position != nil
+		}
+		return *sorted[i].Position < *sorted[j].Position
+	})
+
+	ids := make([]string, 0, len(sorted))
+	for _, rule := range sorted {
+		ids = append(ids, rule.ID)
+	}
+	return ids
+}
+
+func unmanagedServiceEventRuleIDs(managed, actual []string) []string {
+	isManaged := make(map[string]bool, len(managed))
+	for _, id := range managed {
+		isManaged[id] = true
+	}
+
+	var unmanaged []string
+	for _, id := range actual {
+		if !isManaged[id] {
+			unmanaged = append(unmanaged, id)
+		}
+	}
+	return unmanaged
+}
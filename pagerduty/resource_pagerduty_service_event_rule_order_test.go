@@ -0,0 +1,209 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPagerDutyServiceEventRuleOrder_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, "foo", "bar", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_event_rule_order.foo", "rules.#", "3"),
+					testAccCheckPagerDutyServiceEventRuleOrderMatches("pagerduty_service_event_rule_order.foo", "foo", "bar", "baz"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, "baz", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_event_rule_order.foo", "rules.#", "3"),
+					testAccCheckPagerDutyServiceEventRuleOrderMatches("pagerduty_service_event_rule_order.foo", "baz", "foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_service_event_rule_order.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPagerDutyServiceEventRuleOrderRead_UnmanagedRules(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/PSVC123/rules" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Rules come in pages of two, so the unmanaged rule is only on the
+		// second one.
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			_, _ = w.Write([]byte(`{"rules":[{"id":"R2","position":1},{"id":"R1","position":0}],"limit":2,"offset":0,"more":true}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"rules":[{"id":"R3","position":2}],"limit":2,"offset":2,"more":false}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	config := &Config{ApiUrl: srv.URL, Token: "foo", SkipCredsValidation: true}
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyServiceEventRuleOrder().Schema, map[string]interface{}{
+		"service": "PSVC123",
+		"rules":   []interface{}{"R1", "R2"},
+	})
+	d.SetId("PSVC123")
+
+	diags := resourcePagerDutyServiceEventRuleOrderRead(context.Background(), d, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := expandConfigList(d.Get("rules").([]interface{})), []string{"R1", "R2", "R3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected rules %v in state, got %v", want, got)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "R3") {
+		t.Errorf("expected a warning about unmanaged rule R3, got %v", diags)
+	}
+}
+
+func testAccCheckPagerDutyServiceEventRuleOrderMatches(n string, rules ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for i, name := range rules {
+			rule, ok := s.RootModule().Resources["pagerduty_service_event_rule."+name]
+			if !ok {
+				return fmt.Errorf("Not found: pagerduty_service_event_rule.%s", name)
+			}
+			if got := rs.Primary.Attributes[fmt.Sprintf("rules.%d", i)]; got != rule.Primary.ID {
+				return fmt.Errorf("Expected rule %s (%s) at position %d, got %s", name, rule.Primary.ID, i, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, first, second, third string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                    = "%s"
+	auto_resolve_timeout    = 1800
+	acknowledgement_timeout = 1800
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "create_alerts_and_incidents"
+}
+
+resource "pagerduty_service_event_rule" "foo" {
+	service  = pagerduty_service.foo.id
+	disabled = true
+	conditions {
+		operator = "and"
+		subconditions {
+			operator = "contains"
+			parameter {
+				value = "disk space"
+				path  = "summary"
+			}
+		}
+	}
+	lifecycle {
+		ignore_changes = [position]
+	}
+}
+
+resource "pagerduty_service_event_rule" "bar" {
+	service    = pagerduty_service.foo.id
+	disabled   = true
+	depends_on = [pagerduty_service_event_rule.foo]
+	conditions {
+		operator = "and"
+		subconditions {
+			operator = "contains"
+			parameter {
+				value = "cpu spike"
+				path  = "summary"
+			}
+		}
+	}
+	lifecycle {
+		ignore_changes = [position]
+	}
+}
+
+resource "pagerduty_service_event_rule" "baz" {
+	service    = pagerduty_service.foo.id
+	disabled   = true
+	depends_on = [pagerduty_service_event_rule.bar]
+	conditions {
+		operator = "and"
+		subconditions {
+			operator = "contains"
+			parameter {
+				value = "slow ping"
+				path  = "summary"
+			}
+		}
+	}
+	lifecycle {
+		ignore_changes = [position]
+	}
+}
+
+resource "pagerduty_service_event_rule_order" "foo" {
+	service = pagerduty_service.foo.id
+	rules = [
+		pagerduty_service_event_rule.%s.id,
+		pagerduty_service_event_rule.%s.id,
+		pagerduty_service_event_rule.%s.id,
+	]
+}
+`, username, email, escalationPolicy, service, first, second, third)
+}
@@ -24,9 +24,9 @@ const (
 
 func resourcePagerDutyServiceIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourcePagerDutyServiceIntegrationCreate,
+		CreateContext: warnServiceIntegrationEmailAttributes(resourcePagerDutyServiceIntegrationCreate),
 		Read:          resourcePagerDutyServiceIntegrationRead,
-		Update:        resourcePagerDutyServiceIntegrationUpdate,
+		UpdateContext: warnServiceIntegrationEmailAttributes(resourcePagerDutyServiceIntegrationUpdate),
 		Delete:        resourcePagerDutyServiceIntegrationDelete,
 		CustomizeDiff: customizeServiceIntegrationDiff(),
 		Importer: &schema.ResourceImporter{
@@ -94,6 +94,14 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"supports_change_events": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"change_events_routing_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"email_incident_creation": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -358,7 +366,6 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 		if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
 			return errors.New(errEmailIntegrationMustHaveEmail)
 		}
-
 		// All this custom diff logic is needed because the email_filters API
 		// response returns a default value for its structure even when this
 		// configuration is sent empty, so it produces a permanent diff on each Read
@@ -386,6 +393,68 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 	}
 }
 
+// emailIntegrationAttributes are the arguments only supported by email
+// integrations. The API silently drops them for every other integration.
+var emailIntegrationAttributes = []string{
+	"integration_email",
+	"email_incident_creation",
+	"email_filter_mode",
+	"email_parsing_fallback",
+	"email_filter",
+	"email_parser",
+}
+
+// unsupportedServiceIntegrationEmailAttribute returns the first email specific
+// argument set in config when type is configured as a non email integration.
+// Vendor integrations are not checked, since their type is only known after
+// apply.
+func unsupportedServiceIntegrationEmailAttribute(config cty.Value) (string, string) {
+	if config.IsNull() || !config.IsKnown() {
+		return "", ""
+	}
+
+	t := config.GetAttr("type")
+	if t.IsNull() || !t.IsKnown() || t.AsString() == "generic_email_inbound_integration" {
+		return "", ""
+	}
+
+	for _, attr := range emailIntegrationAttributes {
+		v := config.GetAttr(attr)
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		if v.Type().IsListType() && v.LengthInt() == 0 {
+			continue
+		}
+		return attr, t.AsString()
+	}
+
+	return "", ""
+}
+
+// warnServiceIntegrationEmailAttributes warns about email specific arguments
+// set on non email integrations, which the API silently drops. It's a warning
+// rather than a plan time error so existing configurations keep applying.
+func warnServiceIntegrationEmailAttributes(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if attr, t := unsupportedServiceIntegrationEmailAttribute(d.GetRawConfig()); attr != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s is ignored for integrations of type %s", attr, t),
+				Detail: fmt.Sprintf("%s can only be set for integrations of type generic_email_inbound_integration, "+
+					"the API drops it for any other type. Setting it on other types will be rejected in a future release.", attr),
+				AttributePath: cty.GetAttrPath(attr),
+			})
+		}
+
+		if err := f(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
 func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integration, error) {
 	serviceIntegration := &pagerduty.Integration{
 		Name: d.Get("name").(string),
@@ -689,6 +758,19 @@ func fetchPagerDutyServiceIntegration(d *schema.ResourceData, meta interface{},
 			}
 		}
 
+		supportsChangeEvents := changeEventsIntegrationTypes[serviceIntegration.Type]
+		if err := d.Set("supports_change_events", supportsChangeEvents); err != nil {
+			return retry.RetryableError(err)
+		}
+
+		changeEventsRoutingKey := ""
+		if supportsChangeEvents {
+			changeEventsRoutingKey = serviceIntegration.IntegrationKey
+		}
+		if err := d.Set("change_events_routing_key", changeEventsRoutingKey); err != nil {
+			return retry.RetryableError(err)
+		}
+
 		if serviceIntegration.IntegrationEmail != "" {
 			if err := d.Set("integration_email", serviceIntegration.IntegrationEmail); err != nil {
 				return retry.RetryableError(err)
@@ -863,6 +945,12 @@ var allowedIntegrationTypes = map[string]bool{
 	"sql_monitor_inbound_integration":           true,
 }
 
+// changeEventsIntegrationTypes defines the integration types whose
+// integration key is accepted as routing key by the Change Events API
+var changeEventsIntegrationTypes = map[string]bool{
+	"events_api_v2_inbound_integration": true,
+}
+
 // getAllowedIntegrationTypesList returns a sorted list of allowed integration types
 // for use in schema validation
 func getAllowedIntegrationTypesList() []string {
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -72,6 +73,10 @@ func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 						"pagerduty_service_integration.foo", "name", serviceIntegration),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service_integration.foo", "type", "generic_events_api_inbound_integration"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "supports_change_events", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "change_events_routing_key", ""),
 				),
 			},
 			{
@@ -110,6 +115,92 @@ func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestUnsupportedServiceIntegrationEmailAttribute(t *testing.T) {
+	configType := resourcePagerDutyServiceIntegration().CoreConfigSchema().ImpliedType()
+	config := func(attrs map[string]cty.Value) cty.Value {
+		vals := map[string]cty.Value{}
+		for name, ty := range configType.AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		for name, v := range attrs {
+			vals[name] = v
+		}
+		return cty.ObjectVal(vals)
+	}
+
+	cases := []struct {
+		name   string
+		config cty.Value
+		attr   string
+	}{
+		{
+			name: "email attribute on non email type",
+			config: config(map[string]cty.Value{
+				"type":                    cty.StringVal("aws_cloudwatch_inbound_integration"),
+				"email_incident_creation": cty.StringVal("use_rules"),
+			}),
+			attr: "email_incident_creation",
+		},
+		{
+			name: "email attribute on email type",
+			config: config(map[string]cty.Value{
+				"type":                    cty.StringVal("generic_email_inbound_integration"),
+				"email_incident_creation": cty.StringVal("use_rules"),
+			}),
+		},
+		{
+			name: "vendor integration",
+			config: config(map[string]cty.Value{
+				"email_incident_creation": cty.StringVal("use_rules"),
+			}),
+		},
+		{
+			name: "no email attributes",
+			config: config(map[string]cty.Value{
+				"type": cty.StringVal("generic_events_api_inbound_integration"),
+			}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if attr, _ := unsupportedServiceIntegrationEmailAttribute(c.config); attr != c.attr {
+				t.Errorf("expected %q, got %q", c.attr, attr)
+			}
+		})
+	}
+}
+
+func TestAccPagerDutyServiceIntegration_ChangeEvents(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationChangeEventsConfig(username, email, escalationPolicy, service, serviceIntegration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "type", "events_api_v2_inbound_integration"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "supports_change_events", "true"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_integration.foo", "change_events_routing_key",
+						"pagerduty_service_integration.foo", "integration_key"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyServiceIntegrationEmail_Filters(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -588,6 +679,49 @@ resource "pagerduty_service_integration" "foo" {
 `, username, email, escalationPolicy, service, serviceIntegration)
 }
 
+func testAccCheckPagerDutyServiceIntegrationChangeEventsConfig(username, email, escalationPolicy, service, serviceIntegration string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  type    = "events_api_v2_inbound_integration"
+}
+`, username, email, escalationPolicy, service, serviceIntegration)
+}
+
 func testAccCheckPagerDutyServiceIntegrationGenericConfigUpdated(username, email, escalationPolicy, service, serviceIntegration string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1,6 +1,8 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
@@ -19,6 +21,7 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateWebhookSubscriptionFilter,
 		Schema: map[string]*schema.Schema{
 			"delivery_method": {
 				Type:     schema.TypeList,
@@ -91,12 +94,14 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateValueDiagFunc(webhookSubscriptionEventTypes),
 				},
 			},
 			"filter": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -119,6 +124,52 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 	}
 }
 
+// webhookSubscriptionEventTypes is the catalog of outbound event types a V3
+// webhook subscription can receive.
+// See https://developer.pagerduty.com/docs/ZG9jOjExMDI5NTkw-v3-overview
+var webhookSubscriptionEventTypes = []string{
+	"incident.acknowledged",
+	"incident.action_invocation.created",
+	"incident.action_invocation.terminated",
+	"incident.action_invocation.updated",
+	"incident.annotated",
+	"incident.conference_bridge.updated",
+	"incident.custom_field_values.updated",
+	"incident.delegated",
+	"incident.escalated",
+	"incident.incident_type.changed",
+	"incident.priority_updated",
+	"incident.reassigned",
+	"incident.reopened",
+	"incident.resolved",
+	"incident.responder.added",
+	"incident.responder.replied",
+	"incident.service_updated",
+	"incident.status_update_published",
+	"incident.triggered",
+	"incident.unacknowledged",
+	"incident.workflow.completed",
+	"incident.workflow.started",
+	"pagey.ping",
+	"service.created",
+	"service.deleted",
+	"service.updated",
+}
+
+// validateWebhookSubscriptionFilter checks at plan time that every filter type
+// other than account_reference references the object it is scoped to.
+func validateWebhookSubscriptionFilter(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	t := diff.Get("filter.0.type").(string)
+	if t == "" || t == "account_reference" || !diff.NewValueKnown("filter.0.id") {
+		return nil
+	}
+
+	if diff.Get("filter.0.id").(string) == "" {
+		return fmt.Errorf("Invalid configuration: filter.id cannot be null when filter.type is %s", t)
+	}
+	return nil
+}
+
 func buildWebhookSubscriptionStruct(d *schema.ResourceData) *pagerduty.WebhookSubscription {
 	webhook := pagerduty.WebhookSubscription{
 		Type:           d.Get("type").(string),
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -71,6 +72,38 @@ func TestAccPagerDutyWebhookSubscription_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyWebhookSubscription_AccountFilter(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionFilterConfig(description, "team_reference", `"incident.triggered"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("filter.id cannot be null when filter.type is team_reference"),
+			},
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionFilterConfig(description, "account_reference", `"incident.not_an_event"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"incident.not_an_event" is an invalid value`),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionFilterConfig(description, "account_reference", `"incident.triggered", "service.created", "incident.workflow.started"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "filter.0.type", "account_reference"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "events.#", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyWebhookSubscriptionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -175,3 +208,21 @@ func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalat
 	}
 	`, username, useremail, escalationPolicy, service, description)
 }
+
+func testAccCheckPagerDutyWebhookSubscriptionFilterConfig(description, filterType, events string) string {
+	return fmt.Sprintf(`
+	resource "pagerduty_webhook_subscription" "foo" {
+		delivery_method {
+			type = "http_delivery_method"
+			url = "https://example.com/receive_a_pagerduty_webhook"
+		}
+		description = "%s"
+		events = [%s]
+		active = true
+		filter {
+			type = "%s"
+		}
+		type = "webhook_subscription"
+	}
+	`, description, events, filterType)
+}
@@ -30,6 +30,14 @@ func suppressScheduleLayerStartDiff(k, oldTime, newTime string, d *schema.Resour
 	return util.SuppressScheduleLayerStartDiff(k, oldTime, newTime, d)
 }
 
+// suppressPastStartDiff suppresses the diff of a start time while the old and
+// new values are both in the past. Schedule layers and maintenance windows
+// start at the current time instead of a past one, so there is nothing to
+// change.
+func suppressPastStartDiff(k, oldTime, newTime string, d *schema.ResourceData) bool {
+	return util.SuppressScheduleLayerStartDiff(k, oldTime, newTime, d)
+}
+
 // func parseRFC3339Time(k, oldTime, newTime string) (time.Time, time.Time, error) {
 // 	return util.ParseRFC3339Time(k, oldTime, newTime)
 // }
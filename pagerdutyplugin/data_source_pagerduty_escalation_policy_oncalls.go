@@ -0,0 +1,179 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type dataSourceEscalationPolicyOnCalls struct{ client *pagerduty.Client }
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceEscalationPolicyOnCalls)(nil)
+
+func (*dataSourceEscalationPolicyOnCalls) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_escalation_policy_oncalls"
+}
+
+func (*dataSourceEscalationPolicyOnCalls) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                   schema.StringAttribute{Computed: true},
+			"escalation_policy_id": schema.StringAttribute{Required: true},
+			"levels": schema.ListAttribute{
+				Computed:    true,
+				Description: "The current on-call entries of every escalation level, including levels nobody is on call for",
+				ElementType: escalationPolicyOnCallsLevelObjectType,
+			},
+		},
+	}
+}
+
+func (d *dataSourceEscalationPolicyOnCalls) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+}
+
+func (d *dataSourceEscalationPolicyOnCalls) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataSourceEscalationPolicyOnCallsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	escalationPolicyID := model.EscalationPolicyID.ValueString()
+	log.Printf("[INFO] Reading PagerDuty on-calls of escalation policy %s", escalationPolicyID)
+
+	var escalationPolicy *pagerduty.EscalationPolicy
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var err error
+		escalationPolicy, err = d.client.GetEscalationPolicyWithContext(ctx, escalationPolicyID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading PagerDuty escalation policy %s", escalationPolicyID),
+			err.Error(),
+		)
+		return
+	}
+
+	var onCalls []pagerduty.OnCall
+	err = apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := d.client.ListOnCallsWithContext(ctx, pagerduty.ListOnCallOptions{
+			Limit:               apiutil.Limit,
+			Offset:              uint(offset),
+			Includes:            []string{"users"},
+			EscalationPolicyIDs: []string{escalationPolicyID},
+		})
+		if err != nil {
+			return false, err
+		}
+
+		onCalls = append(onCalls, resp.OnCalls...)
+		return resp.More, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading PagerDuty on-calls of escalation policy %s", escalationPolicyID),
+			err.Error(),
+		)
+		return
+	}
+
+	model.ID = types.StringValue(escalationPolicyID)
+	model.Levels = flattenEscalationPolicyOnCallsLevels(len(escalationPolicy.EscalationRules), onCalls, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type dataSourceEscalationPolicyOnCallsModel struct {
+	ID                 types.String `tfsdk:"id"`
+	EscalationPolicyID types.String `tfsdk:"escalation_policy_id"`
+	Levels             types.List   `tfsdk:"levels"`
+}
+
+var escalationPolicyOnCallObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_id":     types.StringType,
+		"user_name":   types.StringType,
+		"user_email":  types.StringType,
+		"schedule_id": types.StringType,
+		"start":       types.StringType,
+		"end":         types.StringType,
+	},
+}
+
+var escalationPolicyOnCallsLevelObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"level":   types.Int64Type,
+		"oncalls": types.ListType{ElemType: escalationPolicyOnCallObjectType},
+	},
+}
+
+// flattenEscalationPolicyOnCallsLevels groups the on-call entries by
+// escalation level. Levels are listed from the escalation rules of the policy,
+// so a level nobody is on call for is still reported with no entries.
+func flattenEscalationPolicyOnCallsLevels(numLevels int, onCalls []pagerduty.OnCall, diags *diag.Diagnostics) types.List {
+	byLevel := make([][]attr.Value, numLevels)
+	for _, onCall := range onCalls {
+		level := int(onCall.EscalationLevel)
+		if level < 1 || level > numLevels {
+			continue
+		}
+
+		scheduleID := types.StringNull()
+		if onCall.Schedule.ID != "" {
+			scheduleID = types.StringValue(onCall.Schedule.ID)
+		}
+		start, end := types.StringNull(), types.StringNull()
+		if onCall.Start != "" {
+			start = types.StringValue(onCall.Start)
+		}
+		if onCall.End != "" {
+			end = types.StringValue(onCall.End)
+		}
+
+		obj, d := types.ObjectValue(escalationPolicyOnCallObjectType.AttrTypes, map[string]attr.Value{
+			"user_id":     types.StringValue(onCall.User.ID),
+			"user_name":   types.StringValue(onCall.User.Name),
+			"user_email":  types.StringValue(onCall.User.Email),
+			"schedule_id": scheduleID,
+			"start":       start,
+			"end":         end,
+		})
+		diags.Append(d...)
+		byLevel[level-1] = append(byLevel[level-1], obj)
+	}
+
+	levels := make([]attr.Value, 0, numLevels)
+	for i, entries := range byLevel {
+		list, d := types.ListValue(escalationPolicyOnCallObjectType, entries)
+		diags.Append(d...)
+
+		obj, d := types.ObjectValue(escalationPolicyOnCallsLevelObjectType.AttrTypes, map[string]attr.Value{
+			"level":   types.Int64Value(int64(i + 1)),
+			"oncalls": list,
+		})
+		diags.Append(d...)
+		levels = append(levels, obj)
+	}
+
+	list, d := types.ListValue(escalationPolicyOnCallsLevelObjectType, levels)
+	diags.Append(d...)
+	return list
+}
@@ -0,0 +1,116 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEscalationPolicyOnCalls_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEscalationPolicyOnCallsConfig(username, email, schedule, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_policy_oncalls.test", "id", "pagerduty_escalation_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.#", "3"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.0.level", "1"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.0.oncalls.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_policy_oncalls.test", "levels.0.oncalls.0.user_id", "pagerduty_user.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.0.oncalls.0.user_email", email),
+					resource.TestCheckNoResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.0.oncalls.0.schedule_id"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.1.oncalls.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_policy_oncalls.test", "levels.1.oncalls.0.schedule_id", "pagerduty_schedule.test", "id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_escalation_policy_oncalls.test", "levels.1.oncalls.0.end"),
+					// Nobody is on call for the third level
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.2.level", "3"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_policy_oncalls.test", "levels.2.oncalls.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEscalationPolicyOnCallsConfig(username, email, schedule, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%[1]s"
+  email = "%[2]s"
+}
+
+resource "pagerduty_schedule" "test" {
+  name      = "%[3]s"
+  time_zone = "America/New_York"
+
+  layer {
+    name                         = "foo"
+    start                        = "2020-01-01T00:00:00-05:00"
+    rotation_virtual_start       = "2020-01-01T00:00:00-05:00"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.test.id]
+  }
+}
+
+resource "pagerduty_schedule" "empty" {
+  name      = "%[3]s-empty"
+  time_zone = "America/New_York"
+
+  layer {
+    name                         = "foo"
+    start                        = "2020-01-01T00:00:00-05:00"
+    rotation_virtual_start       = "2020-01-01T00:00:00-05:00"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.test.id]
+
+    restriction {
+      type              = "weekly_restriction"
+      start_day_of_week = 1
+      start_time_of_day = "00:00:00"
+      duration_seconds  = 1
+    }
+  }
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%[4]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "schedule_reference"
+      id   = pagerduty_schedule.test.id
+    }
+  }
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "schedule_reference"
+      id   = pagerduty_schedule.empty.id
+    }
+  }
+}
+
+data "pagerduty_escalation_policy_oncalls" "test" {
+  escalation_policy_id = pagerduty_escalation_policy.test.id
+}
+`, username, email, schedule, escalationPolicy)
+}
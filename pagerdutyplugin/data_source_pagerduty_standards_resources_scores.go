@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -28,7 +29,8 @@ func (d *dataSourceStandardsResourcesScores) Schema(_ context.Context, _ datasou
 		Attributes: map[string]schema.Attribute{
 			"ids": schema.ListAttribute{
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"resource_type": schema.StringAttribute{
 				Required: true,
@@ -54,25 +56,71 @@ func (d *dataSourceStandardsResourcesScores) Read(ctx context.Context, req datas
 
 	rt := data.ResourceType.ValueString()
 	ids := make([]string, 0)
-	resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &ids, true)...)
-
-	opt := pagerduty.ListMultiResourcesStandardScoresOptions{IDs: ids}
-	scores, err := d.client.ListMultiResourcesStandardScores(ctx, rt, opt)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic(
-			"Error calling ListResourceStandardScores",
-			err.Error(),
-		))
+	if data.IDs.IsNull() || data.IDs.IsUnknown() {
+		ids = d.listAllServiceIDs(ctx, &resp.Diagnostics)
+		idsValue, di := types.ListValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(di...)
+		data.IDs = idsValue
+	} else {
+		resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &ids, true)...)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resources, di := resourceStandardScoresToModel(scores.Resources)
+	// The scores endpoint accepts a limited number of ids per request, so
+	// bigger lists are queried in batches.
+	var scores []pagerduty.ResourceStandardScore
+	for start := 0; start < len(ids); start += standardsResourcesScoresMaxIDs {
+		end := min(start+standardsResourcesScoresMaxIDs, len(ids))
+		opt := pagerduty.ListMultiResourcesStandardScoresOptions{IDs: ids[start:end]}
+		batch, err := d.client.ListMultiResourcesStandardScores(ctx, rt, opt)
+		if err != nil {
+			resp.Diagnostics.Append(diag.NewErrorDiagnostic(
+				"Error calling ListResourceStandardScores",
+				err.Error(),
+			))
+			return
+		}
+		scores = append(scores, batch.Resources...)
+	}
+
+	resources, di := resourceStandardScoresToModel(scores)
 	resp.Diagnostics.Append(di...)
 	data.Resources = resources
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// standardsResourcesScoresMaxIDs is the maximum number of ids accepted by a
+// single call to the standards scores endpoint.
+const standardsResourcesScoresMaxIDs = 100
+
+// listAllServiceIDs returns the id of every technical service in the account,
+// used when no ids are configured.
+func (d *dataSourceStandardsResourcesScores) listAllServiceIDs(ctx context.Context, diags *diag.Diagnostics) []string {
+	ids := make([]string, 0)
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := d.client.ListServicesWithContext(ctx, pagerduty.ListServiceOptions{
+			Limit:  apiutil.Limit,
+			Offset: uint(offset),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for _, service := range resp.Services {
+			ids = append(ids, service.ID)
+		}
+
+		return resp.More, nil
+	})
+	if err != nil {
+		diags.AddError("Error listing services", err.Error())
+	}
+	return ids
+}
+
 type dataSourceStandardsResourcesScoresModel struct {
 	IDs          types.List   `tfsdk:"ids"`
 	ResourceType types.String `tfsdk:"resource_type"`
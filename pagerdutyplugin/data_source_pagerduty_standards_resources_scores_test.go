@@ -26,6 +26,24 @@ func TestAccDataSourcePagerDutyStandardsResourcesScores_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyStandardsResourcesScores_AllServices(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStandardsResourcesScoresAllServicesConfig(name),
+				Check: testAccCheckAttributes(
+					fmt.Sprintf("data.pagerduty_standards_resources_scores.%s", name),
+					testStandardsResourcesScores,
+				),
+			},
+		},
+	})
+}
+
 func testStandardsResourcesScores(a map[string]string) error {
 	testAttrs := []string{
 		"ids.#",
@@ -90,3 +108,36 @@ data "pagerduty_standards_resources_scores" "%s" {
   ids           = [pagerduty_service.example.id]
 }`, name)
 }
+
+func testAccDataSourcePagerDutyStandardsResourcesScoresAllServicesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "Earline Greenholt"
+  email = "126.greenholt.earline@graham.name"
+}
+
+resource "pagerduty_escalation_policy" "bar" {
+  name      = "Testing Escalation Policy"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "example" {
+  name                    = "My Web App test"
+  auto_resolve_timeout    = 14400
+  acknowledgement_timeout = 600
+  escalation_policy       = pagerduty_escalation_policy.bar.id
+  alert_creation          = "create_alerts_and_incidents"
+}
+
+data "pagerduty_standards_resources_scores" "%s" {
+  resource_type = "technical_services"
+  depends_on    = [pagerduty_service.example]
+}`, name)
+}
@@ -0,0 +1,60 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type functionNormalizeRotationStart struct{}
+
+var _ function.Function = (*functionNormalizeRotationStart)(nil)
+
+func (f *functionNormalizeRotationStart) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_rotation_start"
+}
+
+func (f *functionNormalizeRotationStart) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a timestamp for use as a schedule layer start",
+		Description: "Parses an RFC3339 timestamp, truncates it to the full minute and " +
+			"returns it in UTC, which is the format accepted by the `start` and " +
+			"`rotation_virtual_start` arguments of schedule layers.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 timestamp, e.g. the result of timestamp() or timeadd().",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionNormalizeRotationStart) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &timestamp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	normalized, err := normalizeRotationStart(timestamp)
+	if err != nil {
+		resp.Diagnostics.AddArgumentError(0, "Invalid timestamp", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, normalized)...)
+}
+
+// normalizeRotationStart returns the timestamp in UTC with its seconds
+// truncated, since PagerDuty only accepts schedule layer starts set to a full
+// minute.
+func normalizeRotationStart(v string) (string, error) {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid timestamp. Expected format: %s (RFC3339)", v, time.RFC3339)
+	}
+	return t.UTC().Truncate(time.Minute).Format(time.RFC3339), nil
+}
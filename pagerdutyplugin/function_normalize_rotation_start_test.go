@@ -0,0 +1,47 @@
+package pagerduty
+
+import (
+	"testing"
+)
+
+func TestNormalizeRotationStart(t *testing.T) {
+	cases := []struct {
+		given   string
+		want    string
+		wantErr bool
+	}{
+		{
+			given: "2026-03-01T09:00:00Z",
+			want:  "2026-03-01T09:00:00Z",
+		},
+		{
+			given: "2026-03-01T09:00:45Z",
+			want:  "2026-03-01T09:00:00Z",
+		},
+		{
+			given: "2026-03-01T09:30:12-03:00",
+			want:  "2026-03-01T12:30:00Z",
+		},
+		{
+			given:   "2026-03-01 09:00",
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		got, err := normalizeRotationStart(c.given)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("expected an error for %q, got %q", c.given, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", c.given, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("want %q; got %q", c.want, got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type functionRoutingKeyFromIntegration struct{}
+
+var _ function.Function = (*functionRoutingKeyFromIntegration)(nil)
+
+func (f *functionRoutingKeyFromIntegration) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "routing_key_from_integration"
+}
+
+func (f *functionRoutingKeyFromIntegration) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Extract the routing key of an integration",
+		Description: "Returns the routing key of a service or event orchestration " +
+			"integration given either the key itself or one of the Events API " +
+			"endpoint URLs that embed it, such as " +
+			"https://events.pagerduty.com/integration/<key>/enqueue.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Integration key or Events API endpoint URL of the integration.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionRoutingKeyFromIntegration) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := routingKeyFromIntegration(id)
+	if err != nil {
+		resp.Diagnostics.AddArgumentError(0, "Invalid integration identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, key)...)
+}
+
+var routingKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9]{32}$`)
+
+// routingKeyFromIntegration looks for a routing key in the value received,
+// accepting the key itself, the "routing_key" or "integration_key" query
+// parameters, and any path segment of an Events API endpoint URL, like
+// /integration/<key>/enqueue or /x-ere/<key>.
+func routingKeyFromIntegration(v string) (string, error) {
+	v = strings.TrimSpace(v)
+	if routingKeyRegexp.MatchString(v) {
+		return v, nil
+	}
+
+	u, err := url.Parse(v)
+	if err == nil && u.Host != "" {
+		q := u.Query()
+		for _, param := range []string{"routing_key", "integration_key"} {
+			if key := q.Get(param); routingKeyRegexp.MatchString(key) {
+				return key, nil
+			}
+		}
+		for _, segment := range strings.Split(u.Path, "/") {
+			if routingKeyRegexp.MatchString(segment) {
+				return segment, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no routing key found in %q. Expected an integration key or an Events API endpoint URL containing it", v)
+}
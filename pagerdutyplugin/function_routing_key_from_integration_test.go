@@ -0,0 +1,57 @@
+package pagerduty
+
+import (
+	"testing"
+)
+
+func TestRoutingKeyFromIntegration(t *testing.T) {
+	key := "a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6"
+
+	cases := []struct {
+		given   string
+		want    string
+		wantErr bool
+	}{
+		{
+			given: key,
+			want:  key,
+		},
+		{
+			given: "https://events.pagerduty.com/integration/" + key + "/enqueue",
+			want:  key,
+		},
+		{
+			given: "https://events.eu.pagerduty.com/x-ere/" + key,
+			want:  key,
+		},
+		{
+			given: "https://events.pagerduty.com/v2/enqueue?routing_key=" + key,
+			want:  key,
+		},
+		{
+			given:   "PXYZ123",
+			wantErr: true,
+		},
+		{
+			given:   "https://events.pagerduty.com/v2/enqueue",
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		got, err := routingKeyFromIntegration(c.given)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("expected an error for %q, got %q", c.given, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", c.given, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("want %q; got %q", c.want, got)
+		}
+	}
+}
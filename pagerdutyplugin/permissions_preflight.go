@@ -0,0 +1,83 @@
+package pagerduty
+
+import (
+	"context"
+	"sync"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// tokenPermissionsLookups holds the permissions of the token of each client
+// configured with preflight_permissions. Resources only receive the client,
+// so it's the key to tell whether the check applies to them.
+var tokenPermissionsLookups sync.Map
+
+type tokenPermissionsLookup struct {
+	mu          sync.Mutex
+	permissions *util.TokenPermissions
+}
+
+// get returns the permissions of the token of client, looking them up the
+// first time. Failed lookups aren't cached, so they're retried by the next
+// plan.
+func (l *tokenPermissionsLookup) get(ctx context.Context, client *pagerduty.Client) (util.TokenPermissions, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.permissions != nil {
+		return *l.permissions, nil
+	}
+
+	var role string
+	user, err := client.GetCurrentUserWithContext(ctx, pagerduty.GetCurrentUserOptions{})
+	if err != nil {
+		// Tokens that don't belong to a user, like account level API tokens,
+		// aren't bound to a role.
+		if !util.IsBadRequestError(err) {
+			return util.TokenPermissions{}, err
+		}
+	} else {
+		role = user.Role
+	}
+
+	l.permissions = &util.TokenPermissions{Role: role}
+	return *l.permissions, nil
+}
+
+func enablePermissionsPreflight(client *pagerduty.Client) {
+	tokenPermissionsLookups.Store(client, &tokenPermissionsLookup{})
+}
+
+// preflightPermissions reports an error when the client was configured with
+// preflight_permissions and its token can't manage resources of the type of
+// r. It's meant to be called when modifying plans, like the CustomizeDiff of
+// the SDK resources, so plans fail before anything is changed instead of in
+// the middle of an apply. Destroy plans and plans without changes aren't
+// checked. The permissions are looked up the first time they're needed.
+func preflightPermissions(ctx context.Context, r resource.Resource, client *pagerduty.Client, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return diags
+	}
+
+	v, ok := tokenPermissionsLookups.Load(client)
+	if !ok {
+		return diags
+	}
+
+	permissions, err := v.(*tokenPermissionsLookup).get(ctx, client)
+	if err != nil {
+		diags.AddError("Failed to look up the permissions of the API token", err.Error())
+		return diags
+	}
+
+	var metadata resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "pagerduty"}, &metadata)
+	if err := permissions.Check(metadata.TypeName); err != nil {
+		diags.AddError("Insufficient API token permissions", err.Error())
+	}
+	return diags
+}
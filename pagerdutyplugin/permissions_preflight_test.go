@@ -0,0 +1,182 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPreflightPermissions(t *testing.T) {
+	ctx := context.Background()
+	r := &resourceTeam{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	team := tftypes.NewValue(objectType, vals)
+	noTeam := tftypes.NewValue(objectType, nil)
+	create := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: team},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: noTeam},
+	}
+	noChanges := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: team},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: team},
+	}
+	destroy := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: noTeam},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: team},
+	}
+
+	cases := []struct {
+		name      string
+		preflight bool
+		status    int
+		body      string
+		req       resource.ModifyPlanRequest
+		wantErr   string
+	}{
+		{
+			name:      "manager",
+			preflight: true,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"user"}}`,
+			req:       create,
+		},
+		{
+			name:      "observer",
+			preflight: true,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"observer"}}`,
+			req:       create,
+			wantErr:   `"observer" role, which can't manage any resource. Managing pagerduty_team requires one of the roles: owner, admin, user`,
+		},
+		{
+			name:      "observer destroying",
+			preflight: true,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"observer"}}`,
+			req:       destroy,
+		},
+		{
+			name:      "observer without changes",
+			preflight: true,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"observer"}}`,
+			req:       noChanges,
+		},
+		{
+			name:      "observer without preflight",
+			preflight: false,
+			status:    http.StatusOK,
+			body:      `{"user":{"id":"PUSER01","role":"observer"}}`,
+			req:       create,
+		},
+		{
+			name:      "account level token",
+			preflight: true,
+			status:    http.StatusBadRequest,
+			body:      `{"error":{"message":"Invalid Input Provided","code":2001,"errors":["This endpoint can only be used with a user-level API key"]}}`,
+			req:       create,
+		},
+		{
+			name:      "lookup failure",
+			preflight: true,
+			status:    http.StatusInternalServerError,
+			body:      `{"error":{"message":"Internal Server Error","code":2000}}`,
+			req:       create,
+			wantErr:   "Failed to look up the permissions of the API token",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/users/me" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			}))
+			defer srv.Close()
+
+			client := pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(srv.URL))
+			if c.preflight {
+				enablePermissionsPreflight(client)
+			}
+
+			diags := preflightPermissions(ctx, r, client, c.req)
+			if c.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() {
+				t.Fatalf("expected error containing %q, got none", c.wantErr)
+			}
+			got := diags.Errors()[0].Summary() + ": " + diags.Errors()[0].Detail()
+			if !strings.Contains(got, c.wantErr) {
+				t.Fatalf("expected error containing %q, got %q", c.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestPreflightPermissionsRetriesFailedLookup(t *testing.T) {
+	ctx := context.Background()
+	r := &resourceTeam{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	create := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, vals)},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"message":"Internal Server Error","code":2000}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"user":{"id":"PUSER01","role":"user"}}`))
+	}))
+	defer srv.Close()
+
+	client := pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(srv.URL))
+	enablePermissionsPreflight(client)
+
+	if diags := preflightPermissions(ctx, r, client, create); !diags.HasError() {
+		t.Fatal("expected the first lookup to fail")
+	}
+	for i := 0; i < 2; i++ {
+		if diags := preflightPermissions(ctx, r, client, create); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("expected the permissions to be looked up twice, got %d", requests)
+	}
+}
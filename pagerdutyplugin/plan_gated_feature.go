@@ -0,0 +1,29 @@
+package pagerduty
+
+import (
+	"log"
+	"sync"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+)
+
+// skipUnavailableFeaturesClients holds the clients configured with
+// skip_unavailable_features. Resources only receive the client, so it's the
+// key to tell whether the setting applies to them.
+var skipUnavailableFeaturesClients sync.Map
+
+func enableSkipUnavailableFeatures(client *pagerduty.Client) {
+	skipUnavailableFeaturesClients.Store(client, true)
+}
+
+// skipPlanGatedFeatureRead reports whether a failed read should leave the
+// resource with its last known state because the feature isn't included in the
+// account plan and the provider was configured with skip_unavailable_features.
+func skipPlanGatedFeatureRead(client *pagerduty.Client, err error, resourceType, id string) bool {
+	if _, ok := skipUnavailableFeaturesClients.Load(client); !ok || !util.IsPlanGatedFeatureError(err) {
+		return false
+	}
+	log.Printf("[WARN] Keeping last known state of %s %s because its feature is not included in the account plan: %s", resourceType, id, err)
+	return true
+}
@@ -0,0 +1,38 @@
+package pagerduty
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+func TestSkipPlanGatedFeatureRead(t *testing.T) {
+	paymentRequired := pagerduty.APIError{StatusCode: http.StatusPaymentRequired}
+	notFound := pagerduty.APIError{StatusCode: http.StatusNotFound}
+
+	skipping := pagerduty.NewClient("foo")
+	enableSkipUnavailableFeatures(skipping)
+	notSkipping := pagerduty.NewClient("foo")
+
+	cases := []struct {
+		name   string
+		client *pagerduty.Client
+		err    error
+		want   bool
+	}{
+		{name: "402 with skip_unavailable_features", client: skipping, err: paymentRequired, want: true},
+		{name: "404 with skip_unavailable_features", client: skipping, err: notFound, want: false},
+		{name: "other error with skip_unavailable_features", client: skipping, err: errors.New("boom"), want: false},
+		{name: "402 without skip_unavailable_features", client: notSkipping, err: paymentRequired, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := skipPlanGatedFeatureRead(c.client, c.err, "pagerduty_service_custom_field", "PFIELD1"); got != c.want {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -49,6 +51,8 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			"token":                       schema.StringAttribute{Optional: true},
 			"user_token":                  schema.StringAttribute{Optional: true},
 			"insecure_tls":                schema.BoolAttribute{Optional: true},
+			"skip_unavailable_features":   schema.BoolAttribute{Optional: true},
+			"preflight_permissions":       schema.BoolAttribute{Optional: true},
 		},
 		Blocks: map[string]schema.Block{
 			"use_app_oauth_scoped_token": useAppOauthScopedTokenBlock,
@@ -61,6 +65,7 @@ func (p *Provider) DataSources(_ context.Context) [](func() datasource.DataSourc
 		func() datasource.DataSource { return &dataSourceAlertGroupingSetting{} },
 		func() datasource.DataSource { return &dataSourceBusinessService{} },
 		func() datasource.DataSource { return &dataSourceEscalationPolicy{} },
+		func() datasource.DataSource { return &dataSourceEscalationPolicyOnCalls{} },
 		func() datasource.DataSource { return &dataSourceExtensionSchema{} },
 		func() datasource.DataSource { return &dataSourceIncidentTypeCustomField{} },
 		func() datasource.DataSource { return &dataSourceIncidentType{} },
@@ -98,17 +103,26 @@ func (p *Provider) Resources(_ context.Context) [](func() resource.Resource) {
 		func() resource.Resource { return &ServiceCustomFieldResource{} },
 		func() resource.Resource { return &resourceServiceDependency{} },
 		func() resource.Resource { return &resourceTagAssignment{} },
+		func() resource.Resource { return &resourceTagAssignments{} },
 		func() resource.Resource { return &resourceTag{} },
 		func() resource.Resource { return &resourceTeamMembership{} },
 		func() resource.Resource { return &resourceTeam{} },
 		func() resource.Resource { return &resourceUserHandoffNotificationRule{} },
 		func() resource.Resource { return &resourceUserNotificationRule{} },
 		func() resource.Resource { return &resourceUserContactMethod{} },
+		func() resource.Resource { return &resourceUsers{} },
 		func() resource.Resource { return &resourceEnablement{} },
 		func() resource.Resource { return &resourceScheduleV2{} },
 	}
 }
 
+func (p *Provider) Functions(_ context.Context) [](func() function.Function) {
+	return [](func() function.Function){
+		func() function.Function { return &functionNormalizeRotationStart{} },
+		func() function.Function { return &functionRoutingKeyFromIntegration{} },
+	}
+}
+
 func New() *Provider {
 	return &Provider{}
 }
@@ -135,6 +149,16 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	}
 
 	skipCredentialsValidation := args.SkipCredentialsValidation.Equal(types.BoolValue(true))
+	if args.SkipCredentialsValidation.IsNull() {
+		if v, ok := os.LookupEnv("PAGERDUTY_SKIP_CREDENTIALS_VALIDATION"); ok && v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid value for PAGERDUTY_SKIP_CREDENTIALS_VALIDATION", err.Error())
+				return
+			}
+			skipCredentialsValidation = b
+		}
+	}
 	insecureTls := args.InsecureTls.Equal(types.BoolValue(true))
 
 	config := Config{
@@ -149,8 +173,12 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		InsecureTls:         insecureTls,
 	}
 
-	if config.APIURLOverride == "" && p.apiURLOverride != "" {
-		config.APIURLOverride = p.apiURLOverride
+	if config.APIURLOverride == "" {
+		if v, ok := os.LookupEnv("PAGERDUTY_API_URL_OVERRIDE"); ok && v != "" {
+			config.APIURLOverride = v
+		} else if p.apiURLOverride != "" {
+			config.APIURLOverride = p.apiURLOverride
+		}
 	}
 
 	if !args.UseAppOauthScopedToken.IsNull() {
@@ -216,6 +244,12 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	if err != nil {
 		resp.Diagnostics.AddError("Cannot obtain plugin client", err.Error())
 	}
+	if client != nil && args.SkipUnavailableFeatures.ValueBool() {
+		enableSkipUnavailableFeatures(client)
+	}
+	if client != nil && args.PreflightPermissions.ValueBool() {
+		enablePermissionsPreflight(client)
+	}
 	p.client = client
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -235,6 +269,8 @@ type providerArguments struct {
 	APIURLOverride            types.String `tfsdk:"api_url_override"`
 	UseAppOauthScopedToken    types.List   `tfsdk:"use_app_oauth_scoped_token"`
 	InsecureTls               types.Bool   `tfsdk:"insecure_tls"`
+	SkipUnavailableFeatures   types.Bool   `tfsdk:"skip_unavailable_features"`
+	PreflightPermissions      types.Bool   `tfsdk:"preflight_permissions"`
 }
 
 type SchemaGetter interface {
@@ -23,6 +23,7 @@ type resourceAddon struct{ client *pagerduty.Client }
 var (
 	_ resource.Resource                = (*resourceAddon)(nil)
 	_ resource.ResourceWithConfigure   = (*resourceAddon)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceAddon)(nil)
 	_ resource.ResourceWithImportState = (*resourceAddon)(nil)
 )
 
@@ -136,6 +137,10 @@ func (r *resourceAddon) Configure(_ context.Context, req resource.ConfigureReque
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceAddon) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceAddon) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
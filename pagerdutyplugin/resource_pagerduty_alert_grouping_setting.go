@@ -160,6 +160,8 @@ func (r *resourceAlertGroupingSetting) ValidateConfig(ctx context.Context, req r
 }
 
 func (r *resourceAlertGroupingSetting) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+
 	planUsesTimeout := r.UsesTimeout(ctx, req.Plan, &resp.Diagnostics)
 	planUsesTimeWindow := r.UsesTimeWindow(ctx, req.Plan, &resp.Diagnostics)
 
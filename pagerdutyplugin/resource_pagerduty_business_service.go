@@ -27,6 +27,7 @@ type resourceBusinessService struct {
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceBusinessService)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceBusinessService)(nil)
 	_ resource.ResourceWithImportState = (*resourceBusinessService)(nil)
 )
 
@@ -68,6 +69,10 @@ func (r *resourceBusinessService) Schema(_ context.Context, _ resource.SchemaReq
 				DeprecationMessage: "This will become a computed attribute in the next major release.",
 				Validators:         []validator.String{stringvalidator.OneOf("business_service")},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to take over an existing business service with the same name instead of creating a new one",
+			},
 		},
 	}
 }
@@ -80,9 +85,32 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	businessServicePlan := buildPagerdutyBusinessService(&plan)
-	log.Printf("[INFO] Creating PagerDuty business service %s", plan.Name)
+	adoptExisting := plan.AdoptExisting
+
+	if adoptExisting.ValueBool() {
+		existing, err := findBusinessServiceByName(ctx, r.client, businessServicePlan.Name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error searching Business Service %s", plan.Name),
+				err.Error(),
+			)
+			return
+		}
+		if existing != nil {
+			businessServicePlan.ID = existing.ID
+		}
+	}
 
 	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		if businessServicePlan.ID != "" {
+			log.Printf("[INFO] Adopting existing PagerDuty business service %s (%s)", plan.Name, businessServicePlan.ID)
+			if _, err := r.client.UpdateBusinessServiceWithContext(ctx, businessServicePlan); err != nil {
+				return retry.NonRetryableError(err)
+			}
+			return nil
+		}
+
+		log.Printf("[INFO] Creating PagerDuty business service %s", plan.Name)
 		bs, err := r.client.CreateBusinessServiceWithContext(ctx, businessServicePlan)
 		if err != nil {
 			return retry.NonRetryableError(err)
@@ -103,6 +131,7 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.AdoptExisting = adoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -114,6 +143,7 @@ func (r *resourceBusinessService) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	log.Printf("[INFO] Reading PagerDuty business service %s", state.ID)
+	adoptExisting := state.AdoptExisting
 
 	state, found := requestGetBusinessService(ctx, r.client, state.ID.ValueString(), false, &resp.Diagnostics)
 	if !found {
@@ -123,6 +153,7 @@ func (r *resourceBusinessService) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.AdoptExisting = adoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -150,7 +181,9 @@ func (r *resourceBusinessService) Update(ctx context.Context, req resource.Updat
 		)
 		return
 	}
+	adoptExisting := plan.AdoptExisting
 	plan = flattenBusinessService(businessService)
+	plan.AdoptExisting = adoptExisting
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -179,6 +212,10 @@ func (r *resourceBusinessService) Configure(_ context.Context, req resource.Conf
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceBusinessService) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceBusinessService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -193,6 +230,7 @@ type resourceBusinessServiceModel struct {
 	Summary        types.String `tfsdk:"summary"`
 	Team           types.String `tfsdk:"team"`
 	Type           types.String `tfsdk:"type"`
+	AdoptExisting  types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func requestGetBusinessService(ctx context.Context, client *pagerduty.Client, id string, retryNotFound bool, diags *diag.Diagnostics) (resourceBusinessServiceModel, bool) {
@@ -224,6 +262,32 @@ func requestGetBusinessService(ctx context.Context, client *pagerduty.Client, id
 	return model, found
 }
 
+// findBusinessServiceByName returns the business service named exactly name,
+// or nil when there is none.
+func findBusinessServiceByName(ctx context.Context, client *pagerduty.Client, name string) (*pagerduty.BusinessService, error) {
+	var found *pagerduty.BusinessService
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		list, err := client.ListBusinessServicesPaginated(ctx, pagerduty.ListBusinessServiceOptions{})
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+
+		for _, bs := range list {
+			if bs.Name == name {
+				found = bs
+				break
+			}
+		}
+		return nil
+	})
+
+	return found, err
+}
+
 func buildPagerdutyBusinessService(model *resourceBusinessServiceModel) *pagerduty.BusinessService {
 	businessService := pagerduty.BusinessService{
 		ID:             model.ID.ValueString(),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -115,6 +116,50 @@ func TestAccPagerDutyBusinessService_SDKv2Compatibility(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyBusinessService_AdoptExisting(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	nameNotExisting := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyBusinessServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					existing, err := testAccProvider.client.CreateBusinessServiceWithContext(context.Background(), &pagerduty.BusinessService{Name: name})
+					if err != nil {
+						t.Fatal(err)
+					}
+					existingID = existing.ID
+				},
+				Config: testAccCheckPagerDutyBusinessServiceAdoptExistingConfig(name, nameNotExisting),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("pagerduty_business_service.foo", "id", func(id string) error {
+						if id != existingID {
+							return fmt.Errorf("expected existing business service %s to be adopted, got %s", existingID, id)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("pagerduty_business_service.foo", "name", name),
+					resource.TestCheckResourceAttr("pagerduty_business_service.foo", "description", "foo"),
+					// Without a business service of the same name a new one is
+					// created.
+					testAccCheckPagerDutyBusinessServiceExists("pagerduty_business_service.bar"),
+					resource.TestCheckResourceAttrWith("pagerduty_business_service.bar", "id", func(id string) error {
+						if id == existingID {
+							return fmt.Errorf("expected a new business service to be created, got the existing one %s", id)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("pagerduty_business_service.bar", "name", nameNotExisting),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyBusinessServiceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -185,6 +230,22 @@ resource "pagerduty_business_service" "foo" {
 `, name, description, poc)
 }
 
+func testAccCheckPagerDutyBusinessServiceAdoptExistingConfig(name, nameNotExisting string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+	name           = "%s"
+	description    = "foo"
+	adopt_existing = true
+}
+
+resource "pagerduty_business_service" "bar" {
+	name           = "%s"
+	description    = "bar"
+	adopt_existing = true
+}
+`, name, nameNotExisting)
+}
+
 func testAccCheckPagerDutyBusinessServiceWithTeamConfig(businessServiceName, teamName, description, poc string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_team" "bar" {
@@ -25,6 +25,7 @@ type resourceEnablement struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceEnablement)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceEnablement)(nil)
 	_ resource.ResourceWithImportState = (*resourceEnablement)(nil)
 )
 
@@ -399,6 +400,10 @@ func (r *resourceEnablement) Configure(_ context.Context, req resource.Configure
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceEnablement) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceEnablement) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ids := strings.Split(req.ID, ".")
 	if len(ids) != 3 {
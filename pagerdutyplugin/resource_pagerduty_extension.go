@@ -15,9 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
@@ -25,8 +27,10 @@ import (
 type resourceExtension struct{ client *pagerduty.Client }
 
 var (
-	_ resource.ResourceWithConfigure   = (*resourceExtension)(nil)
-	_ resource.ResourceWithImportState = (*resourceExtension)(nil)
+	_ resource.ResourceWithConfigure      = (*resourceExtension)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceExtension)(nil)
+	_ resource.ResourceWithImportState    = (*resourceExtension)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceExtension)(nil)
 )
 
 func (r *resourceExtension) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,10 +83,15 @@ func (r *resourceExtension) Schema(_ context.Context, _ resource.SchemaRequest,
 				CustomType:    jsontypes.NormalizedType{},
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
+			"temporarily_disabled": extensionTemporarilyDisabledAttribute,
 		},
 	}
 }
 
+func (r *resourceExtension) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateExtensionTemporarilyDisabled(ctx, req.Config, &resp.Diagnostics)
+}
+
 func (r *resourceExtension) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model resourceExtensionModel
 
@@ -143,6 +152,7 @@ func (r *resourceExtension) Read(ctx context.Context, req resource.ReadRequest,
 			err.Error(),
 		)
 	}
+	addExtensionTemporarilyDisabledWarning(state.ID.ValueString(), state.TemporarilyDisabled, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -176,6 +186,11 @@ func (r *resourceExtension) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	requestEnableExtension(ctx, r.client, plan.ID, req.Plan, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	accessToken := buildExtensionConfigAccessToken(model.Config, &resp.Diagnostics)
 	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -209,6 +224,10 @@ func (r *resourceExtension) Configure(_ context.Context, req resource.ConfigureR
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceExtension) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceExtension) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	model := requestGetExtension(ctx, r.client, req.ID, nil, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -218,15 +237,68 @@ func (r *resourceExtension) ImportState(ctx context.Context, req resource.Import
 }
 
 type resourceExtensionModel struct {
-	Name             types.String         `tfsdk:"name"`
-	Config           jsontypes.Normalized `tfsdk:"config"`
-	EndpointURL      types.String         `tfsdk:"endpoint_url"`
-	ExtensionObjects types.Set            `tfsdk:"extension_objects"`
-	ExtensionSchema  types.String         `tfsdk:"extension_schema"`
-	HTMLURL          types.String         `tfsdk:"html_url"`
-	ID               types.String         `tfsdk:"id"`
-	Summary          types.String         `tfsdk:"summary"`
-	Type             types.String         `tfsdk:"type"`
+	Name                types.String         `tfsdk:"name"`
+	Config              jsontypes.Normalized `tfsdk:"config"`
+	EndpointURL         types.String         `tfsdk:"endpoint_url"`
+	ExtensionObjects    types.Set            `tfsdk:"extension_objects"`
+	ExtensionSchema     types.String         `tfsdk:"extension_schema"`
+	HTMLURL             types.String         `tfsdk:"html_url"`
+	ID                  types.String         `tfsdk:"id"`
+	Summary             types.String         `tfsdk:"summary"`
+	Type                types.String         `tfsdk:"type"`
+	TemporarilyDisabled types.Bool           `tfsdk:"temporarily_disabled"`
+}
+
+// extensionTemporarilyDisabledAttribute exposes whether PagerDuty disabled an
+// extension after repeated delivery failures. It can only be configured as
+// false, which re-enables the extension on apply.
+var extensionTemporarilyDisabledAttribute = schema.BoolAttribute{
+	Optional:      true,
+	Computed:      true,
+	PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+}
+
+func validateExtensionTemporarilyDisabled(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var disabled types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root("temporarily_disabled"), &disabled)...)
+	if disabled.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("temporarily_disabled"),
+			"Invalid configuration",
+			"Extensions can only be disabled by PagerDuty. Set 'temporarily_disabled' to false to re-enable a disabled extension, or leave it unset",
+		)
+	}
+}
+
+// requestEnableExtension re-enables an extension disabled by PagerDuty when
+// the plan sets temporarily_disabled to false.
+func requestEnableExtension(ctx context.Context, client *pagerduty.Client, id string, plan tfsdk.Plan, state tfsdk.State, diags *diag.Diagnostics) {
+	var planned, current types.Bool
+	diags.Append(plan.GetAttribute(ctx, path.Root("temporarily_disabled"), &planned)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("temporarily_disabled"), &current)...)
+	if planned.IsNull() || planned.IsUnknown() || planned.ValueBool() || !current.ValueBool() {
+		return
+	}
+
+	log.Printf("[INFO] Enabling PagerDuty extension %s", id)
+	if _, err := client.EnableExtension(ctx, id); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error enabling extension %s", id),
+			err.Error(),
+		)
+	}
+}
+
+func addExtensionTemporarilyDisabledWarning(id string, disabled types.Bool, diags *diag.Diagnostics) {
+	if !disabled.ValueBool() {
+		return
+	}
+	diags.AddAttributeWarning(
+		path.Root("temporarily_disabled"),
+		fmt.Sprintf("Extension %s is temporarily disabled", id),
+		"PagerDuty disabled this extension after repeated delivery failures. "+
+			"Once the endpoint is fixed, set 'temporarily_disabled' to false to re-enable it",
+	)
 }
 
 func requestGetExtension(ctx context.Context, client *pagerduty.Client, id string, accessToken *string, diags *diag.Diagnostics) resourceExtensionModel {
@@ -332,15 +404,16 @@ func buildExtensionConfigAccessToken(s jsontypes.Normalized, diags *diag.Diagnos
 
 func flattenExtension(response *pagerduty.Extension, accessToken *string, diags *diag.Diagnostics) resourceExtensionModel {
 	model := resourceExtensionModel{
-		ID:               types.StringValue(response.ID),
-		Name:             types.StringValue(response.Name),
-		HTMLURL:          types.StringValue(response.HTMLURL),
-		Type:             types.StringValue(response.Type),
-		Summary:          types.StringValue(response.Summary),
-		EndpointURL:      types.StringValue(response.EndpointURL),
-		Config:           flattenExtensionConfig(response.Config, accessToken, diags),
-		ExtensionSchema:  types.StringValue(response.ExtensionSchema.ID),
-		ExtensionObjects: flattenExtensionObjects(response.ExtensionObjects, diags),
+		ID:                  types.StringValue(response.ID),
+		Name:                types.StringValue(response.Name),
+		HTMLURL:             types.StringValue(response.HTMLURL),
+		Type:                types.StringValue(response.Type),
+		Summary:             types.StringValue(response.Summary),
+		EndpointURL:         types.StringValue(response.EndpointURL),
+		Config:              flattenExtensionConfig(response.Config, accessToken, diags),
+		ExtensionSchema:     types.StringValue(response.ExtensionSchema.ID),
+		ExtensionObjects:    flattenExtensionObjects(response.ExtensionObjects, diags),
+		TemporarilyDisabled: types.BoolValue(response.TemporarilyDisabled),
 	}
 	return model
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
@@ -26,8 +27,10 @@ import (
 type resourceExtensionServiceNow struct{ client *pagerduty.Client }
 
 var (
-	_ resource.ResourceWithConfigure   = (*resourceExtensionServiceNow)(nil)
-	_ resource.ResourceWithImportState = (*resourceExtensionServiceNow)(nil)
+	_ resource.ResourceWithConfigure      = (*resourceExtensionServiceNow)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceExtensionServiceNow)(nil)
+	_ resource.ResourceWithImportState    = (*resourceExtensionServiceNow)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceExtensionServiceNow)(nil)
 )
 
 func (r *resourceExtensionServiceNow) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,13 +87,41 @@ func (r *resourceExtensionServiceNow) Schema(_ context.Context, _ resource.Schem
 					stringvalidator.OneOf("manual_sync", "sync_all"),
 				},
 			},
-			"target":    schema.StringAttribute{Required: true},
-			"task_type": schema.StringAttribute{Required: true},
-			"referer":   schema.StringAttribute{Required: true},
+			"target":               schema.StringAttribute{Required: true},
+			"task_type":            schema.StringAttribute{Required: true},
+			"referer":              schema.StringAttribute{Required: true},
+			"temporarily_disabled": extensionTemporarilyDisabledAttribute,
 		},
 	}
 }
 
+func (r *resourceExtensionServiceNow) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateExtensionTemporarilyDisabled(ctx, req.Config, &resp.Diagnostics)
+
+	// Targets and task types the API accepts are only warned about, so
+	// configurations that apply today keep applying.
+	var target, taskType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target"), &target)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("task_type"), &taskType)...)
+
+	if !target.IsNull() && !target.IsUnknown() {
+		if u, err := url.Parse(target.ValueString()); err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("target"),
+				"Target is not an absolute URL",
+				fmt.Sprintf("%q is not an absolute https or http URL, so ServiceNow may not be reachable from PagerDuty", target.ValueString()),
+			)
+		}
+	}
+	if !taskType.IsNull() && !taskType.IsUnknown() && taskType.ValueString() != "incident" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("task_type"),
+			"Unsupported task type",
+			fmt.Sprintf("The ServiceNow extension only creates tasks of type \"incident\", got %q", taskType.ValueString()),
+		)
+	}
+}
+
 func (r *resourceExtensionServiceNow) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model resourceExtensionServiceNowModel
 
@@ -148,6 +179,7 @@ func (r *resourceExtensionServiceNow) Read(ctx context.Context, req resource.Rea
 		}
 		return
 	}
+	addExtensionTemporarilyDisabledWarning(id, state.TemporarilyDisabled, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -177,6 +209,11 @@ func (r *resourceExtensionServiceNow) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	requestEnableExtension(ctx, r.client, plan.ID, req.Plan, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	model, err = r.requestGetExtensionServiceNow(ctx, requestGetExtensionServiceNowOptions{
 		ID:            plan.ID,
 		RetryNotFound: true,
@@ -218,6 +255,10 @@ func (r *resourceExtensionServiceNow) Configure(_ context.Context, req resource.
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceExtensionServiceNow) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceExtensionServiceNow) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	model, err := r.requestGetExtensionServiceNow(ctx, requestGetExtensionServiceNowOptions{
 		ID:            req.ID,
@@ -234,20 +275,21 @@ func (r *resourceExtensionServiceNow) ImportState(ctx context.Context, req resou
 }
 
 type resourceExtensionServiceNowModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	HTMLURL          types.String `tfsdk:"html_url"`
-	Type             types.String `tfsdk:"type"`
-	EndpointURL      types.String `tfsdk:"endpoint_url"`
-	ExtensionObjects types.Set    `tfsdk:"extension_objects"`
-	ExtensionSchema  types.String `tfsdk:"extension_schema"`
-	SnowUser         types.String `tfsdk:"snow_user"`
-	SnowPassword     types.String `tfsdk:"snow_password"`
-	Summary          types.String `tfsdk:"summary"`
-	SyncOptions      types.String `tfsdk:"sync_options"`
-	Target           types.String `tfsdk:"target"`
-	TaskType         types.String `tfsdk:"task_type"`
-	Referer          types.String `tfsdk:"referer"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	HTMLURL             types.String `tfsdk:"html_url"`
+	Type                types.String `tfsdk:"type"`
+	EndpointURL         types.String `tfsdk:"endpoint_url"`
+	ExtensionObjects    types.Set    `tfsdk:"extension_objects"`
+	ExtensionSchema     types.String `tfsdk:"extension_schema"`
+	SnowUser            types.String `tfsdk:"snow_user"`
+	SnowPassword        types.String `tfsdk:"snow_password"`
+	Summary             types.String `tfsdk:"summary"`
+	SyncOptions         types.String `tfsdk:"sync_options"`
+	Target              types.String `tfsdk:"target"`
+	TaskType            types.String `tfsdk:"task_type"`
+	Referer             types.String `tfsdk:"referer"`
+	TemporarilyDisabled types.Bool   `tfsdk:"temporarily_disabled"`
 }
 
 type requestGetExtensionServiceNowOptions struct {
@@ -326,11 +368,12 @@ func buildExtensionServiceNowObjects(ctx context.Context, set types.Set, diags *
 
 func flattenExtensionServiceNow(src *pagerduty.Extension, snowPassword *string, endpointURL *string) resourceExtensionServiceNowModel {
 	model := resourceExtensionServiceNowModel{
-		ID:               types.StringValue(src.ID),
-		Name:             types.StringValue(src.Name),
-		HTMLURL:          types.StringValue(src.HTMLURL),
-		ExtensionSchema:  types.StringValue(src.ExtensionSchema.ID),
-		ExtensionObjects: flattenExtensionServiceNowObjects(src.ExtensionObjects),
+		ID:                  types.StringValue(src.ID),
+		Name:                types.StringValue(src.Name),
+		HTMLURL:             types.StringValue(src.HTMLURL),
+		ExtensionSchema:     types.StringValue(src.ExtensionSchema.ID),
+		ExtensionObjects:    flattenExtensionServiceNowObjects(src.ExtensionObjects),
+		TemporarilyDisabled: types.BoolValue(src.TemporarilyDisabled),
 	}
 
 	b, _ := json.Marshal(src.Config)
@@ -8,6 +8,9 @@ import (
 	"testing"
 
 	"github.com/PagerDuty/go-pagerduty"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -40,6 +43,49 @@ func testSweepExtensionServiceNow(_ string) error {
 	return nil
 }
 
+func TestExtensionServiceNowValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &resourceExtensionServiceNow{}
+
+	var schemaResp frameworkresource.SchemaResponse
+	r.Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	cases := []struct {
+		name     string
+		target   string
+		taskType string
+		warnings int
+	}{
+		{name: "valid", target: "https://foo.servicenow.com/webhook_foo", taskType: "incident"},
+		{name: "host only target", target: "foo.servicenow.com/webhook_foo", taskType: "incident", warnings: 1},
+		{name: "other task type", target: "https://foo.servicenow.com/webhook_foo", taskType: "problem", warnings: 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vals := map[string]tftypes.Value{}
+			for name, typ := range objectType.AttributeTypes {
+				vals[name] = tftypes.NewValue(typ, nil)
+			}
+			vals["target"] = tftypes.NewValue(tftypes.String, c.target)
+			vals["task_type"] = tftypes.NewValue(tftypes.String, c.taskType)
+
+			req := frameworkresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, vals)},
+			}
+			var resp frameworkresource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != c.warnings {
+				t.Errorf("expected %d warnings, got %d: %v", c.warnings, got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 	extensionName := id.PrefixedUniqueId("tf-")
 	extensionNameUpdated := id.PrefixedUniqueId("tf-")
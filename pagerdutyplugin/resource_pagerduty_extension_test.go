@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -67,6 +68,8 @@ func TestAccPagerDutyExtension_Basic(t *testing.T) {
 						"pagerduty_extension.foo", "config", util.CheckJSONEqual("{\"notify_types\":{\"acknowledge\":false,\"assignments\":false,\"resolve\":false},\"restrict\":\"any\"}")),
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension.foo", "html_url", ""),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "temporarily_disabled", "false"),
 				),
 			},
 			{
@@ -87,6 +90,33 @@ func TestAccPagerDutyExtension_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyExtension_TemporarilyDisabled(t *testing.T) {
+	extensionName := id.PrefixedUniqueId("tf-")
+	name := id.PrefixedUniqueId("tf-")
+	url := "https://example.com/receive_a_pagerduty_webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyExtensionConfigTemporarilyDisabled(name, extensionName, url, "true"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Extensions can only be disabled by PagerDuty"),
+			},
+			{
+				Config: testAccCheckPagerDutyExtensionConfigTemporarilyDisabled(name, extensionName, url, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionExists("pagerduty_extension.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "temporarily_disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyExtensionDestroy(s *terraform.State) error {
 	for _, r := range s.RootModule().Resources {
 		if r.Type != "pagerduty_extension" {
@@ -190,6 +220,59 @@ EOF
 `, name, extensionName, url, restrict, notifyTypes)
 }
 
+func testAccCheckPagerDutyExtensionConfigTemporarilyDisabled(name, extensionName, url, temporarilyDisabled string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%[1]v"
+  email       = "%[1]v@foo.test"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%[1]v"
+  description = "bar"
+  num_loops   = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%[1]v"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "high"
+  }
+}
+
+data "pagerduty_extension_schema" "foo" {
+  name = "Generic V2 Webhook"
+}
+
+resource "pagerduty_extension" "foo" {
+  name                 = "%[2]v"
+  endpoint_url         = "%[3]v"
+  extension_schema     = data.pagerduty_extension_schema.foo.id
+  extension_objects    = [pagerduty_service.foo.id]
+  temporarily_disabled = %[4]v
+}
+`, name, extensionName, url, temporarilyDisabled)
+}
+
 func testAccCheckPagerDutyExtensionConfig_NoEndpointURL(name, extension_name, notify_types, restrict string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
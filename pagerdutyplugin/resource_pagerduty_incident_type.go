@@ -24,6 +24,7 @@ type resourceIncidentType struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceIncidentType)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceIncidentType)(nil)
 	_ resource.ResourceWithImportState = (*resourceIncidentType)(nil)
 )
 
@@ -229,6 +230,10 @@ func (r *resourceIncidentType) Configure(_ context.Context, req resource.Configu
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceIncidentType) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceIncidentType) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
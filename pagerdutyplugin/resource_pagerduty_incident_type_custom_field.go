@@ -28,6 +28,7 @@ type resourceIncidentTypeCustomField struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceIncidentTypeCustomField)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceIncidentTypeCustomField)(nil)
 	_ resource.ResourceWithImportState = (*resourceIncidentTypeCustomField)(nil)
 )
 
@@ -204,7 +205,7 @@ func (r *resourceIncidentTypeCustomField) Create(ctx context.Context, req resour
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		response, err := r.client.CreateIncidentTypeField(ctx, model.IncidentType.ValueString(), plan)
 		if err != nil {
-			if util.IsBadRequestError(err) {
+			if util.IsBadRequestError(err) || util.IsPlanGatedFeatureError(err) {
 				return retry.NonRetryableError(err)
 			}
 			return retry.RetryableError(err)
@@ -212,6 +213,10 @@ func (r *resourceIncidentTypeCustomField) Create(ctx context.Context, req resour
 		fieldID = response.ID
 		return nil
 	})
+	if util.IsPlanGatedFeatureError(err) {
+		resp.Diagnostics.AddError(util.FeatureIncidentCustomFields.Diagnostic("pagerduty_incident_type_custom_field", "", err))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error creating PagerDuty incident type custom field %s", plan.Name),
@@ -253,6 +258,13 @@ func (r *resourceIncidentTypeCustomField) Read(ctx context.Context, req resource
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if skipPlanGatedFeatureRead(r.client, err, "pagerduty_incident_type_custom_field", id.ValueString()) {
+			return
+		}
+		if util.IsPlanGatedFeatureError(err) {
+			resp.Diagnostics.AddError(util.FeatureIncidentCustomFields.Diagnostic("pagerduty_incident_type_custom_field", id.ValueString(), err))
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error reading PagerDuty incident type custom field %s", id),
 			err.Error(),
@@ -334,6 +346,10 @@ func (r *resourceIncidentTypeCustomField) Update(ctx context.Context, req resour
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if util.IsPlanGatedFeatureError(err) {
+			resp.Diagnostics.AddError(util.FeatureIncidentCustomFields.Diagnostic("pagerduty_incident_type_custom_field", model.ID.ValueString(), err))
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating PagerDuty incident type custom field %s", model.ID),
 			err.Error(),
@@ -412,6 +428,10 @@ func (r *resourceIncidentTypeCustomField) Configure(ctx context.Context, req res
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceIncidentTypeCustomField) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceIncidentTypeCustomField) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -440,7 +460,7 @@ func requestGetIncidentTypeCustomField(ctx context.Context, client *pagerduty.Cl
 			Includes: []string{"field_options"},
 		})
 		if err != nil {
-			if util.IsBadRequestError(err) {
+			if util.IsBadRequestError(err) || util.IsPlanGatedFeatureError(err) {
 				return retry.NonRetryableError(err)
 			}
 			if !retryNotFound && util.IsNotFoundError(err) {
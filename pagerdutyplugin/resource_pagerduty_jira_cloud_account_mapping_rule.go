@@ -29,6 +29,7 @@ type resourceJiraCloudAccountMappingRule struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceJiraCloudAccountMappingRule)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceJiraCloudAccountMappingRule)(nil)
 	_ resource.ResourceWithImportState = (*resourceJiraCloudAccountMappingRule)(nil)
 )
 
@@ -323,6 +324,10 @@ func (r *resourceJiraCloudAccountMappingRule) Configure(ctx context.Context, req
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceJiraCloudAccountMappingRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceJiraCloudAccountMappingRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if _, _, err := util.ResourcePagerDutyParseColonCompoundID(req.ID); err != nil {
 		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid Value", err.Error())
@@ -26,6 +26,7 @@ type resourceScheduleV2 struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceScheduleV2)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceScheduleV2)(nil)
 	_ resource.ResourceWithImportState = (*resourceScheduleV2)(nil)
 )
 
@@ -165,6 +166,10 @@ func (r *resourceScheduleV2) Configure(_ context.Context, req resource.Configure
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceScheduleV2) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceScheduleV2) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model resourceScheduleV2Model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
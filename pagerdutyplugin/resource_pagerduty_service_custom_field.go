@@ -24,6 +24,7 @@ import (
 var (
 	_ resource.Resource                = &ServiceCustomFieldResource{}
 	_ resource.ResourceWithConfigure   = &ServiceCustomFieldResource{}
+	_ resource.ResourceWithModifyPlan  = &ServiceCustomFieldResource{}
 	_ resource.ResourceWithImportState = &ServiceCustomFieldResource{}
 )
 
@@ -147,6 +148,10 @@ func (r *ServiceCustomFieldResource) Configure(_ context.Context, req resource.C
 	r.client = client
 }
 
+func (r *ServiceCustomFieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *ServiceCustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ServiceCustomFieldResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -204,6 +209,10 @@ func (r *ServiceCustomFieldResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	createdField, err := r.client.CreateServiceCustomField(ctx, &field)
+	if util.IsPlanGatedFeatureError(err) {
+		resp.Diagnostics.AddError(util.FeatureServiceCustomFields.Diagnostic("pagerduty_service_custom_field", "", err))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating PagerDuty Service Custom Field",
@@ -280,6 +289,13 @@ func (r *ServiceCustomFieldResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if skipPlanGatedFeatureRead(r.client, err, "pagerduty_service_custom_field", state.ID.ValueString()) {
+			return
+		}
+		if util.IsPlanGatedFeatureError(err) {
+			resp.Diagnostics.AddError(util.FeatureServiceCustomFields.Diagnostic("pagerduty_service_custom_field", state.ID.ValueString(), err))
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading PagerDuty Service Custom Field",
 			fmt.Sprintf("Could not read service custom field %s: %s", state.ID.ValueString(), err),
@@ -398,6 +414,10 @@ func (r *ServiceCustomFieldResource) Update(ctx context.Context, req resource.Up
 	}
 
 	updatedField, err := r.client.UpdateServiceCustomField(ctx, &field)
+	if util.IsPlanGatedFeatureError(err) {
+		resp.Diagnostics.AddError(util.FeatureServiceCustomFields.Diagnostic("pagerduty_service_custom_field", plan.ID.ValueString(), err))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating PagerDuty Service Custom Field",
@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &ServiceCustomFieldValueResource{}
 	_ resource.ResourceWithConfigure   = &ServiceCustomFieldValueResource{}
+	_ resource.ResourceWithModifyPlan  = &ServiceCustomFieldValueResource{}
 	_ resource.ResourceWithImportState = &ServiceCustomFieldValueResource{}
 )
 
@@ -100,6 +101,10 @@ func (r *ServiceCustomFieldValueResource) Configure(_ context.Context, req resou
 	r.client = client
 }
 
+func (r *ServiceCustomFieldValueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *ServiceCustomFieldValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ServiceCustomFieldValueResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
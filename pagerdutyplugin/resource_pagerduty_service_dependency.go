@@ -31,6 +31,7 @@ type resourceServiceDependency struct {
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceServiceDependency)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceServiceDependency)(nil)
 	_ resource.ResourceWithImportState = (*resourceServiceDependency)(nil)
 )
 
@@ -395,6 +396,10 @@ func (r *resourceServiceDependency) Configure(_ context.Context, req resource.Co
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceServiceDependency) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceServiceDependency) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ids := strings.Split(req.ID, ".")
 	if len(ids) != 3 {
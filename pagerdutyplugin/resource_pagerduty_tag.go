@@ -24,6 +24,7 @@ type resourceTag struct {
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTag)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceTag)(nil)
 	_ resource.ResourceWithImportState = (*resourceTag)(nil)
 )
 
@@ -31,6 +32,10 @@ func (r *resourceTag) Configure(_ context.Context, req resource.ConfigureRequest
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceTag) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceTag) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "pagerduty_tag"
 }
@@ -24,6 +24,7 @@ type resourceTagAssignment struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTagAssignment)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceTagAssignment)(nil)
 	_ resource.ResourceWithImportState = (*resourceTagAssignment)(nil)
 )
 
@@ -261,6 +262,10 @@ func (r *resourceTagAssignment) Configure(_ context.Context, req resource.Config
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceTagAssignment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceTagAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ids := strings.Split(req.ID, ".")
 	if len(ids) != 3 {
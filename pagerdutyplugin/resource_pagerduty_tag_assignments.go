@@ -0,0 +1,336 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// resourceTagAssignments manages every tag assigned to an entity with a
+// single change_tags request, instead of one pagerduty_tag_assignment per tag.
+type resourceTagAssignments struct{ client *pagerduty.Client }
+
+var (
+	_ resource.ResourceWithConfigure   = (*resourceTagAssignments)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceTagAssignments)(nil)
+	_ resource.ResourceWithImportState = (*resourceTagAssignments)(nil)
+)
+
+func (r *resourceTagAssignments) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_tag_assignments"
+}
+
+func (r *resourceTagAssignments) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"entity_type": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf("users", "teams", "escalation_policies"),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"tag_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"preserve_unmanaged_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *resourceTagAssignments) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceTagAssignmentsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityType, entityID := model.EntityType.ValueString(), model.EntityID.ValueString()
+	log.Printf("[INFO] Creating PagerDuty tag assignments for %s entity with ID %s", entityType, entityID)
+
+	r.reconcileTagAssignments(ctx, model, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.ID = flattenTagAssignmentsID(entityType, entityID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceTagAssignments) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceTagAssignmentsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty tag assignments %s", state.ID)
+
+	current, err := r.requestGetEntityTagIDs(ctx, state.EntityType.ValueString(), state.EntityID.ValueString())
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading tags for %s entity with ID %s", state.EntityType, state.EntityID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Tags added outside of Terraform are only reported as drift when the
+	// full set of tags is managed.
+	if state.PreserveUnmanagedTags.ValueBool() {
+		managed := make([]string, 0)
+		resp.Diagnostics.Append(state.TagIDs.ElementsAs(ctx, &managed, false)...)
+		current = intersectTagIDs(current, managed)
+	}
+
+	tagIDs, diags := types.SetValueFrom(ctx, types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+	state.TagIDs = tagIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceTagAssignments) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceTagAssignmentsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Updating PagerDuty tag assignments %s", plan.ID)
+
+	previous := make([]string, 0)
+	resp.Diagnostics.Append(state.TagIDs.ElementsAs(ctx, &previous, false)...)
+
+	r.reconcileTagAssignments(ctx, plan, previous, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTagAssignments) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceTagAssignmentsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityType, entityID := state.EntityType.ValueString(), state.EntityID.ValueString()
+	log.Printf("[INFO] Deleting PagerDuty tag assignments for %s entity with ID %s", entityType, entityID)
+
+	tagIDs := make([]string, 0)
+	resp.Diagnostics.Append(state.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignments := &pagerduty.TagAssignments{Remove: buildTagReferences(tagIDs)}
+	if err := r.requestChangeTags(ctx, entityType, entityID, assignments); err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting PagerDuty tag assignments for %s entity with ID %s", entityType, entityID),
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceTagAssignments) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+}
+
+func (r *resourceTagAssignments) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
+func (r *resourceTagAssignments) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ".")
+	if len(ids) != 2 {
+		resp.Diagnostics.AddError(
+			"Error importing pagerduty_tag_assignments",
+			"Expecting an importation ID formed as '<entity_type>.<entity_id>'",
+		)
+		return
+	}
+	entityType, entityID := ids[0], ids[1]
+
+	current, err := r.requestGetEntityTagIDs(ctx, entityType, entityID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing pagerduty_tag_assignments",
+			err.Error(),
+		)
+		return
+	}
+
+	tagIDs, diags := types.SetValueFrom(ctx, types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+
+	state := resourceTagAssignmentsModel{
+		ID:                    flattenTagAssignmentsID(entityType, entityID),
+		EntityType:            types.StringValue(entityType),
+		EntityID:              types.StringValue(entityID),
+		TagIDs:                tagIDs,
+		PreserveUnmanagedTags: types.BoolValue(false),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// reconcileTagAssignments adds the desired tags missing from the entity and
+// removes the undesired ones in a single request. When unmanaged tags are
+// preserved, only tags previously managed by the resource are removed.
+func (r *resourceTagAssignments) reconcileTagAssignments(ctx context.Context, model resourceTagAssignmentsModel, previous []string, diags *diag.Diagnostics) {
+	entityType, entityID := model.EntityType.ValueString(), model.EntityID.ValueString()
+
+	desired := make([]string, 0)
+	diags.Append(model.TagIDs.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	current, err := r.requestGetEntityTagIDs(ctx, entityType, entityID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading tags for %s entity with ID %s", entityType, entityID),
+			err.Error(),
+		)
+		return
+	}
+
+	add := subtractTagIDs(desired, current)
+	remove := subtractTagIDs(current, desired)
+	if model.PreserveUnmanagedTags.ValueBool() {
+		remove = intersectTagIDs(remove, previous)
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return
+	}
+
+	assignments := &pagerduty.TagAssignments{
+		Add:    buildTagReferences(add),
+		Remove: buildTagReferences(remove),
+	}
+	if err := r.requestChangeTags(ctx, entityType, entityID, assignments); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating PagerDuty tag assignments for %s entity with ID %s", entityType, entityID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Allow time for tag assignments to propagate across regions (eventual consistency)
+	time.Sleep(5 * time.Second)
+}
+
+func (r *resourceTagAssignments) requestChangeTags(ctx context.Context, entityType, entityID string, assignments *pagerduty.TagAssignments) error {
+	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := r.client.AssignTagsWithContext(ctx, entityType, entityID, assignments)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+func (r *resourceTagAssignments) requestGetEntityTagIDs(ctx context.Context, entityType, entityID string) ([]string, error) {
+	var tagIDs []string
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		tags, err := r.client.GetTagsForEntityPaginated(ctx, entityType, entityID, pagerduty.ListTagOptions{})
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+
+		tagIDs = make([]string, 0, len(tags))
+		for _, tag := range tags {
+			tagIDs = append(tagIDs, tag.ID)
+		}
+		sort.Strings(tagIDs)
+		return nil
+	})
+	return tagIDs, err
+}
+
+type resourceTagAssignmentsModel struct {
+	ID                    types.String `tfsdk:"id"`
+	EntityType            types.String `tfsdk:"entity_type"`
+	EntityID              types.String `tfsdk:"entity_id"`
+	TagIDs                types.Set    `tfsdk:"tag_ids"`
+	PreserveUnmanagedTags types.Bool   `tfsdk:"preserve_unmanaged_tags"`
+}
+
+func flattenTagAssignmentsID(entityType, entityID string) types.String {
+	return types.StringValue(fmt.Sprintf("%v.%v", entityType, entityID))
+}
+
+func buildTagReferences(tagIDs []string) []*pagerduty.TagAssignment {
+	var list []*pagerduty.TagAssignment
+	for _, id := range tagIDs {
+		list = append(list, &pagerduty.TagAssignment{Type: "tag_reference", TagID: id})
+	}
+	return list
+}
+
+// subtractTagIDs returns the ids of a which are not in b.
+func subtractTagIDs(a, b []string) []string {
+	exclude := make(map[string]bool, len(b))
+	for _, id := range b {
+		exclude[id] = true
+	}
+
+	result := make([]string, 0)
+	for _, id := range a {
+		if !exclude[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// intersectTagIDs returns the ids of a which are also in b.
+func intersectTagIDs(a, b []string) []string {
+	return subtractTagIDs(a, subtractTagIDs(a, b))
+}
@@ -0,0 +1,169 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPagerDutyTagAssignments_Team(t *testing.T) {
+	tagLabel := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTagAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tagLabel, team, `[pagerduty_tag.foo.id, pagerduty_tag.bar.id]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "tag_ids.#", "2"),
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "preserve_unmanaged_tags", "false"),
+					testAccCheckPagerDutyTagAssignmentsCount("pagerduty_tag_assignments.foo", 2),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tagLabel, team, `[pagerduty_tag.bar.id]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "tag_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("pagerduty_tag_assignments.foo", "tag_ids.*", "pagerduty_tag.bar", "id"),
+					testAccCheckPagerDutyTagAssignmentsCount("pagerduty_tag_assignments.foo", 1),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_tag_assignments.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTagAssignments_PreserveUnmanagedTags(t *testing.T) {
+	tagLabel := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTagAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tagLabel, team, `[pagerduty_tag.foo.id]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "tag_ids.#", "1"),
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "preserve_unmanaged_tags", "true"),
+				),
+			},
+			// Tags assigned outside of the resource are neither removed nor
+			// reported as drift
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tagLabel, team, `[pagerduty_tag.foo.id]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAssignTagExternally("pagerduty_team.foo", "pagerduty_tag.bar", "teams"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTagAssignmentsConfig(tagLabel, team, `[pagerduty_tag.foo.id]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_tag_assignments.foo", "tag_ids.#", "1"),
+					testAccCheckPagerDutyTagAssignmentsCount("pagerduty_tag_assignments.foo", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyTagAssignmentsDestroy(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_tag_assignments" {
+			continue
+		}
+		ids := strings.Split(r.Primary.ID, ".")
+		entityType, entityID := ids[0], ids[1]
+
+		response, err := testAccProvider.client.GetTagsForEntity(entityType, entityID, pagerduty.ListTagOptions{})
+		if err != nil {
+			// if the entity is gone its tags are gone too
+			return nil
+		}
+		managed := make(map[string]bool)
+		for k, v := range r.Primary.Attributes {
+			if strings.HasPrefix(k, "tag_ids.") && k != "tag_ids.#" {
+				managed[v] = true
+			}
+		}
+		for _, tag := range response.Tags {
+			if managed[tag.ID] {
+				return fmt.Errorf("Tag %s still exists and is connected to %s ID %s", tag.ID, entityType, entityID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyTagAssignmentsCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		ids := strings.Split(rs.Primary.ID, ".")
+		entityType, entityID := ids[0], ids[1]
+
+		response, err := testAccProvider.client.GetTagsForEntity(entityType, entityID, pagerduty.ListTagOptions{})
+		if err != nil {
+			return err
+		}
+		if len(response.Tags) != expected {
+			return fmt.Errorf("Expected %d tags connected to %s ID %s, got %d", expected, entityType, entityID, len(response.Tags))
+		}
+		return nil
+	}
+}
+
+func testAccAssignTagExternally(entity, tag, entityType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		entityState, ok := s.RootModule().Resources[entity]
+		if !ok {
+			return fmt.Errorf("Not found: %s", entity)
+		}
+		tagState, ok := s.RootModule().Resources[tag]
+		if !ok {
+			return fmt.Errorf("Not found: %s", tag)
+		}
+
+		assignments := &pagerduty.TagAssignments{
+			Add: []*pagerduty.TagAssignment{
+				{Type: "tag_reference", TagID: tagState.Primary.ID},
+			},
+		}
+		return testAccProvider.client.AssignTags(entityType, entityState.Primary.ID, assignments)
+	}
+}
+
+func testAccCheckPagerDutyTagAssignmentsConfig(tagLabel, team, tagIDs string, preserve bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+	label = "%[1]s-foo"
+}
+resource "pagerduty_tag" "bar" {
+	label = "%[1]s-bar"
+}
+resource "pagerduty_team" "foo" {
+	name = "%[2]s"
+}
+resource "pagerduty_tag_assignments" "foo" {
+	entity_type             = "teams"
+	entity_id               = pagerduty_team.foo.id
+	tag_ids                 = %[3]s
+	preserve_unmanaged_tags = %[4]t
+}
+`, tagLabel, team, tagIDs, preserve)
+}
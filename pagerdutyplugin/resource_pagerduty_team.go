@@ -8,6 +8,7 @@ import (
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,6 +23,7 @@ type resourceTeam struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTeam)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceTeam)(nil)
 	_ resource.ResourceWithImportState = (*resourceTeam)(nil)
 )
 
@@ -53,6 +55,10 @@ func (r *resourceTeam) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"parent": schema.StringAttribute{Optional: true},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to take over an existing team with the same name instead of creating a new one",
+			},
 		},
 	}
 }
@@ -65,9 +71,35 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	plan := buildPagerdutyTeam(&model)
-	log.Printf("[INFO] Creating PagerDuty team %s", plan.Name)
+	adoptExisting := model.AdoptExisting
+
+	if adoptExisting.ValueBool() {
+		existing, err := findTeamByName(ctx, r.client, plan.Name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error searching PagerDuty team %s", plan.Name),
+				err.Error(),
+			)
+			return
+		}
+		if existing != nil {
+			plan.ID = existing.ID
+		}
+	}
 
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		if plan.ID != "" {
+			log.Printf("[INFO] Adopting existing PagerDuty team %s (%s)", plan.Name, plan.ID)
+			if _, err := r.client.UpdateTeamWithContext(ctx, plan.ID, plan); err != nil {
+				if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			return nil
+		}
+
+		log.Printf("[INFO] Creating PagerDuty team %s", plan.Name)
 		response, err := r.client.CreateTeamWithContext(ctx, plan)
 		if err != nil {
 			if util.IsBadRequestError(err) {
@@ -95,6 +127,7 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
+	model.AdoptExisting = adoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -108,6 +141,7 @@ func (r *resourceTeam) Read(ctx context.Context, req resource.ReadRequest, resp
 	log.Printf("[INFO] Reading PagerDuty team %s", state.ID)
 
 	plan := buildPagerdutyTeam(&state)
+	adoptExisting := state.AdoptExisting
 
 	retryNotFound := false
 	state, err := requestGetTeam(ctx, r.client, plan, retryNotFound)
@@ -122,6 +156,7 @@ func (r *resourceTeam) Read(ctx context.Context, req resource.ReadRequest, resp
 		)
 		return
 	}
+	state.AdoptExisting = adoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -140,6 +175,7 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		plan.ID = id
 	}
 	log.Printf("[INFO] Updating PagerDuty team %s", plan.ID)
+	adoptExisting := model.AdoptExisting
 
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		team, err := r.client.UpdateTeamWithContext(ctx, plan.ID, plan)
@@ -173,6 +209,7 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
+	model.AdoptExisting = adoptExisting
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -210,17 +247,22 @@ func (r *resourceTeam) Configure(ctx context.Context, req resource.ConfigureRequ
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceTeam) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceTeam) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 type resourceTeamModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	DefaultRole types.String `tfsdk:"default_role"`
-	Description types.String `tfsdk:"description"`
-	HTMLURL     types.String `tfsdk:"html_url"`
-	Parent      types.String `tfsdk:"parent"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	DefaultRole   types.String `tfsdk:"default_role"`
+	Description   types.String `tfsdk:"description"`
+	HTMLURL       types.String `tfsdk:"html_url"`
+	Parent        types.String `tfsdk:"parent"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func requestGetTeam(ctx context.Context, client *pagerduty.Client, plan *pagerduty.Team, retryNotFound bool) (resourceTeamModel, error) {
@@ -244,6 +286,33 @@ func requestGetTeam(ctx context.Context, client *pagerduty.Client, plan *pagerdu
 	return model, err
 }
 
+// findTeamByName returns the team named exactly name, or nil when there is
+// none. The teams query matches names partially, so results are filtered.
+func findTeamByName(ctx context.Context, client *pagerduty.Client, name string) (*pagerduty.Team, error) {
+	var found *pagerduty.Team
+
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := client.ListTeamsWithContext(ctx, pagerduty.ListTeamOptions{
+			Query:  name,
+			Limit:  apiutil.Limit,
+			Offset: uint(offset),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for i := range resp.Teams {
+			if resp.Teams[i].Name == name {
+				found = &resp.Teams[i]
+				return false, nil
+			}
+		}
+		return resp.More, nil
+	})
+
+	return found, err
+}
+
 func buildPagerdutyTeam(model *resourceTeamModel) *pagerduty.Team {
 	var parent *pagerduty.APIObject
 	if !model.Parent.IsNull() && !model.Parent.IsUnknown() {
@@ -30,6 +30,7 @@ type resourceTeamMembership struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTeamMembership)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceTeamMembership)(nil)
 	_ resource.ResourceWithImportState = (*resourceTeamMembership)(nil)
 )
 
@@ -301,6 +302,10 @@ func (r *resourceTeamMembership) Configure(ctx context.Context, req resource.Con
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceTeamMembership) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceTeamMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -160,6 +160,41 @@ func TestAccPagerDutyTeam_Parent(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeam_AdoptExisting(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					existing, err := testAccProvider.client.CreateTeamWithContext(context.Background(), &pagerduty.Team{Name: team})
+					if err != nil {
+						t.Fatal(err)
+					}
+					existingID = existing.ID
+				},
+				Config: testAccCheckPagerDutyTeamAdoptExistingConfig(team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("pagerduty_team.foo", "id", func(id string) error {
+						if id != existingID {
+							return fmt.Errorf("expected existing team %s to be adopted, got %s", existingID, id)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "name", team),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "description", "foo"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyTeamDestroy(s *terraform.State) error {
 	ctx := context.Background()
 
@@ -197,6 +232,15 @@ resource "pagerduty_team" "foo" {
 }`, team)
 }
 
+func testAccCheckPagerDutyTeamAdoptExistingConfig(team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name           = "%s"
+  description    = "foo"
+  adopt_existing = true
+}`, team)
+}
+
 func testAccCheckPagerDutyTeamConfigUpdated(team string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_team" "foo" {
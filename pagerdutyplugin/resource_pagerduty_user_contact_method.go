@@ -26,6 +26,7 @@ type resourceUserContactMethod struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure      = (*resourceUserContactMethod)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceUserContactMethod)(nil)
 	_ resource.ResourceWithImportState    = (*resourceUserContactMethod)(nil)
 	_ resource.ResourceWithValidateConfig = (*resourceUserContactMethod)(nil)
 )
@@ -259,6 +260,10 @@ func (r *resourceUserContactMethod) Configure(ctx context.Context, req resource.
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceUserContactMethod) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceUserContactMethod) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ids := strings.Split(req.ID, ":")
 	if len(ids) != 2 {
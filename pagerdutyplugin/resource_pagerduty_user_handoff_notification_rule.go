@@ -30,6 +30,7 @@ type resourceUserHandoffNotificationRule struct {
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceUserHandoffNotificationRule)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceUserHandoffNotificationRule)(nil)
 	_ resource.ResourceWithImportState = (*resourceUserHandoffNotificationRule)(nil)
 )
 
@@ -233,6 +234,10 @@ func (r *resourceUserHandoffNotificationRule) Configure(ctx context.Context, req
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceUserHandoffNotificationRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceUserHandoffNotificationRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ids := strings.Split(req.ID, ".")
 	if len(ids) != 2 {
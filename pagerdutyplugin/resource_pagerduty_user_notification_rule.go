@@ -27,6 +27,7 @@ type resourceUserNotificationRule struct{ client *pagerduty.Client }
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceUserNotificationRule)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceUserNotificationRule)(nil)
 	_ resource.ResourceWithImportState = (*resourceUserNotificationRule)(nil)
 )
 
@@ -215,6 +216,10 @@ func (r *resourceUserNotificationRule) Configure(ctx context.Context, req resour
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
+func (r *resourceUserNotificationRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
 func (r *resourceUserNotificationRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ids := strings.Split(req.ID, ":")
 	if len(ids) != 2 {
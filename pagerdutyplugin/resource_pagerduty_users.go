@@ -0,0 +1,524 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"golang.org/x/sync/errgroup"
+)
+
+// usersConcurrentRequests is the maximum number of user requests sent to
+// PagerDuty's API at the same time by pagerduty_users.
+const usersConcurrentRequests = 10
+
+// resourceUsers manages a group of users keyed by email. Users are read with
+// a single paginated listing and only the users which changed are created,
+// updated or deleted, concurrently.
+type resourceUsers struct{ client *pagerduty.Client }
+
+var (
+	_ resource.ResourceWithConfigure   = (*resourceUsers)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceUsers)(nil)
+	_ resource.ResourceWithImportState = (*resourceUsers)(nil)
+)
+
+func (r *resourceUsers) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_users"
+}
+
+func (r *resourceUsers) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"users": schema.MapNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"name": schema.StringAttribute{Required: true},
+						"role": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("user"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									"admin",
+									"limited_user",
+									"observer",
+									"owner",
+									"read_only_user",
+									"restricted_access",
+									"read_only_limited_user",
+									"user",
+								),
+							},
+						},
+						"job_title": schema.StringAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"time_zone": schema.StringAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"color": schema.StringAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("Managed by Terraform"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceUsers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceUsersModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := make(map[string]resourceUsersUserModel)
+	resp.Diagnostics.Append(model.Users.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Creating %d PagerDuty users", len(desired))
+
+	created, failed := r.runUserRequests(sortedUserKeys(desired), func(email string) (*pagerduty.User, error) {
+		return r.requestCreateUser(ctx, buildUsersUser(email, desired[email]))
+	})
+
+	// The group is created as a whole, so users created before a failure are
+	// deleted again instead of being left behind outside of the state.
+	if len(failed) > 0 {
+		addUsersErrors(&resp.Diagnostics, "Error creating PagerDuty user", failed)
+
+		_, rollbackFailed := r.runUserRequests(sortedUserKeys(created), func(email string) (*pagerduty.User, error) {
+			return nil, r.requestDeleteUser(ctx, created[email].ID)
+		})
+		addUsersErrors(&resp.Diagnostics, "Error deleting PagerDuty user created before the failure", rollbackFailed)
+		return
+	}
+
+	users := make(map[string]resourceUsersUserModel, len(created))
+	for email, user := range created {
+		users[email] = flattenUsersUser(user)
+	}
+
+	model.ID = types.StringValue(id.UniqueId())
+	model.Users = flattenUsersMap(ctx, users, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceUsers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceUsersModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty users %s", state.ID)
+
+	previous := make(map[string]resourceUsersUserModel)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.requestListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty users", err.Error())
+		return
+	}
+
+	users := make(map[string]resourceUsersUserModel, len(previous))
+	for email, user := range previous {
+		found, ok := current[user.ID.ValueString()]
+		if !ok {
+			log.Printf("[WARN] Removing PagerDuty user %s (%s) from pagerduty_users because it's gone", user.ID, email)
+			continue
+		}
+		users[email] = flattenUsersUser(found)
+	}
+
+	state.Users = flattenUsersMap(ctx, users, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceUsers) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceUsersModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Updating PagerDuty users %s", plan.ID)
+
+	desired := make(map[string]resourceUsersUserModel)
+	previous := make(map[string]resourceUsersUserModel)
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &desired, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toCreate, toUpdate, toDelete []string
+	for email, user := range desired {
+		prev, ok := previous[email]
+		if !ok {
+			toCreate = append(toCreate, email)
+		} else if isUsersUserChanged(prev, user) {
+			toUpdate = append(toUpdate, email)
+		}
+	}
+	for email := range previous {
+		if _, ok := desired[email]; !ok {
+			toDelete = append(toDelete, email)
+		}
+	}
+	sort.Strings(toCreate)
+	sort.Strings(toUpdate)
+	sort.Strings(toDelete)
+	log.Printf("[INFO] Creating %d, updating %d and deleting %d PagerDuty users", len(toCreate), len(toUpdate), len(toDelete))
+
+	// Users keep their previous state until their request succeeds, so a
+	// partial failure is retried on the next apply.
+	users := make(map[string]resourceUsersUserModel, len(previous))
+	for email, user := range previous {
+		users[email] = user
+	}
+
+	deleted, failed := r.runUserRequests(toDelete, func(email string) (*pagerduty.User, error) {
+		return nil, r.requestDeleteUser(ctx, previous[email].ID.ValueString())
+	})
+	addUsersErrors(&resp.Diagnostics, "Error deleting PagerDuty user", failed)
+	for email := range deleted {
+		delete(users, email)
+	}
+
+	created, failed := r.runUserRequests(toCreate, func(email string) (*pagerduty.User, error) {
+		return r.requestCreateUser(ctx, buildUsersUser(email, desired[email]))
+	})
+	addUsersErrors(&resp.Diagnostics, "Error creating PagerDuty user", failed)
+
+	updated, failed := r.runUserRequests(toUpdate, func(email string) (*pagerduty.User, error) {
+		user := buildUsersUser(email, desired[email])
+		user.ID = previous[email].ID.ValueString()
+		return r.requestUpdateUser(ctx, user)
+	})
+	addUsersErrors(&resp.Diagnostics, "Error updating PagerDuty user", failed)
+
+	for email, user := range created {
+		users[email] = flattenUsersUser(user)
+	}
+	for email, user := range updated {
+		users[email] = flattenUsersUser(user)
+	}
+
+	plan.Users = flattenUsersMap(ctx, users, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceUsers) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceUsersModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := make(map[string]resourceUsersUserModel)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Deleting %d PagerDuty users", len(previous))
+
+	_, failed := r.runUserRequests(sortedUserKeys(previous), func(email string) (*pagerduty.User, error) {
+		err := r.requestDeleteUser(ctx, previous[email].ID.ValueString())
+		if util.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	})
+	addUsersErrors(&resp.Diagnostics, "Error deleting PagerDuty user", failed)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceUsers) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+}
+
+func (r *resourceUsers) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(preflightPermissions(ctx, r, r.client, req)...)
+}
+
+func (r *resourceUsers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userIDs := strings.Split(req.ID, ",")
+
+	current, err := r.requestListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing pagerduty_users", err.Error())
+		return
+	}
+
+	users := make(map[string]resourceUsersUserModel, len(userIDs))
+	for _, userID := range userIDs {
+		user, ok := current[strings.TrimSpace(userID)]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Error importing pagerduty_users",
+				fmt.Sprintf("User %s was not found. Expecting an importation ID formed as a comma separated list of user IDs", userID),
+			)
+			return
+		}
+		users[strings.ToLower(user.Email)] = flattenUsersUser(user)
+	}
+
+	state := resourceUsersModel{
+		ID:    types.StringValue(id.UniqueId()),
+		Users: flattenUsersMap(ctx, users, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// runUserRequests calls fn for every email with at most
+// usersConcurrentRequests requests in flight. It returns the users of the
+// calls which succeeded and the errors of the ones which failed, by email.
+func (r *resourceUsers) runUserRequests(emails []string, fn func(email string) (*pagerduty.User, error)) (map[string]*pagerduty.User, map[string]error) {
+	var mu sync.Mutex
+	succeeded := make(map[string]*pagerduty.User, len(emails))
+	failed := make(map[string]error)
+
+	var g errgroup.Group
+	g.SetLimit(usersConcurrentRequests)
+	for _, email := range emails {
+		g.Go(func() error {
+			user, err := fn(email)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[email] = err
+			} else {
+				succeeded[email] = user
+			}
+			return nil
+		})
+	}
+	g.Wait()
+
+	return succeeded, failed
+}
+
+func (r *resourceUsers) requestListUsers(ctx context.Context) (map[string]*pagerduty.User, error) {
+	users := make(map[string]*pagerduty.User)
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := r.client.ListUsersWithContext(ctx, pagerduty.ListUsersOptions{
+			Limit:  apiutil.Limit,
+			Offset: uint(offset),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for i := range resp.Users {
+			users[resp.Users[i].ID] = &resp.Users[i]
+		}
+		return resp.More, nil
+	})
+	return users, err
+}
+
+func (r *resourceUsers) requestCreateUser(ctx context.Context, user pagerduty.User) (*pagerduty.User, error) {
+	var created *pagerduty.User
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var err error
+		created, err = r.client.CreateUserWithContext(ctx, user)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	return created, err
+}
+
+func (r *resourceUsers) requestUpdateUser(ctx context.Context, user pagerduty.User) (*pagerduty.User, error) {
+	var updated *pagerduty.User
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var err error
+		updated, err = r.client.UpdateUserWithContext(ctx, user)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+func (r *resourceUsers) requestDeleteUser(ctx context.Context, userID string) error {
+	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := r.client.DeleteUserWithContext(ctx, userID)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+type resourceUsersModel struct {
+	ID    types.String `tfsdk:"id"`
+	Users types.Map    `tfsdk:"users"`
+}
+
+type resourceUsersUserModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Role        types.String `tfsdk:"role"`
+	JobTitle    types.String `tfsdk:"job_title"`
+	TimeZone    types.String `tfsdk:"time_zone"`
+	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+}
+
+var resourceUsersUserObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"role":        types.StringType,
+		"job_title":   types.StringType,
+		"time_zone":   types.StringType,
+		"color":       types.StringType,
+		"description": types.StringType,
+	},
+}
+
+func buildUsersUser(email string, model resourceUsersUserModel) pagerduty.User {
+	return pagerduty.User{
+		Name:        strings.TrimSpace(model.Name.ValueString()),
+		Email:       email,
+		Role:        model.Role.ValueString(),
+		JobTitle:    model.JobTitle.ValueString(),
+		Timezone:    model.TimeZone.ValueString(),
+		Color:       model.Color.ValueString(),
+		Description: model.Description.ValueString(),
+	}
+}
+
+func flattenUsersUser(user *pagerduty.User) resourceUsersUserModel {
+	model := resourceUsersUserModel{
+		ID:          types.StringValue(user.ID),
+		Name:        types.StringValue(user.Name),
+		Role:        types.StringValue(user.Role),
+		JobTitle:    types.StringValue(user.JobTitle),
+		TimeZone:    types.StringValue(user.Timezone),
+		Color:       types.StringValue(user.Color),
+		Description: types.StringValue(user.Description),
+	}
+	return model
+}
+
+func flattenUsersMap(ctx context.Context, users map[string]resourceUsersUserModel, diags *diag.Diagnostics) types.Map {
+	value, d := types.MapValueFrom(ctx, resourceUsersUserObjectType, users)
+	diags.Append(d...)
+	return value
+}
+
+// isUsersUserChanged reports whether a user needs to be updated. Unknown
+// values are computed by the API and never trigger an update on their own.
+func isUsersUserChanged(previous, desired resourceUsersUserModel) bool {
+	pairs := [][2]types.String{
+		{previous.Name, desired.Name},
+		{previous.Role, desired.Role},
+		{previous.JobTitle, desired.JobTitle},
+		{previous.TimeZone, desired.TimeZone},
+		{previous.Color, desired.Color},
+		{previous.Description, desired.Description},
+	}
+	for _, p := range pairs {
+		if p[1].IsUnknown() {
+			continue
+		}
+		if !p[0].Equal(p[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedUserKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func addUsersErrors(diags *diag.Diagnostics, summary string, failed map[string]error) {
+	for _, email := range sortedUserKeys(failed) {
+		diags.AddError(fmt.Sprintf("%s %s", summary, email), failed[email].Error())
+	}
+}
@@ -0,0 +1,161 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPagerDutyUsers_Basic(t *testing.T) {
+	prefix := fmt.Sprintf("tf-%s", strings.ToLower(acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyUsersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUsersConfig(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_users.foo", "users.%", "2"),
+					resource.TestCheckResourceAttrSet("pagerduty_users.foo", fmt.Sprintf("users.%s-alice@foo.test.id", prefix)),
+					resource.TestCheckResourceAttr("pagerduty_users.foo", fmt.Sprintf("users.%s-alice@foo.test.role", prefix), "user"),
+					resource.TestCheckResourceAttr("pagerduty_users.foo", fmt.Sprintf("users.%s-alice@foo.test.description", prefix), "Managed by Terraform"),
+					resource.TestCheckResourceAttr("pagerduty_users.foo", fmt.Sprintf("users.%s-bob@foo.test.job_title", prefix), "SRE"),
+					testAccCheckPagerDutyUsersExist("pagerduty_users.foo"),
+				),
+			},
+			{
+				// Removing job_title from the configuration keeps the
+				// current one without a diff.
+				Config: testAccCheckPagerDutyUsersConfigJobTitleRemoved(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_users.foo", fmt.Sprintf("users.%s-bob@foo.test.job_title", prefix), "SRE"),
+					testAccCheckPagerDutyUsersExist("pagerduty_users.foo"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUsersConfigUpdated(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_users.foo", "users.%", "2"),
+					resource.TestCheckResourceAttr("pagerduty_users.foo", fmt.Sprintf("users.%s-alice@foo.test.role", prefix), "observer"),
+					resource.TestCheckNoResourceAttr("pagerduty_users.foo", fmt.Sprintf("users.%s-bob@foo.test.id", prefix)),
+					resource.TestCheckResourceAttrSet("pagerduty_users.foo", fmt.Sprintf("users.%s-carol@foo.test.id", prefix)),
+					testAccCheckPagerDutyUsersExist("pagerduty_users.foo"),
+				),
+			},
+			{
+				ResourceName:            "pagerduty_users.foo",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccPagerDutyUsersImportStateID("pagerduty_users.foo"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id"},
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUsersDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_users" {
+			continue
+		}
+		for _, userID := range testAccPagerDutyUsersIDs(r) {
+			if _, err := testAccProvider.client.GetUserWithContext(ctx, userID, pagerduty.GetUserOptions{}); err == nil {
+				return fmt.Errorf("User %s still exists", userID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyUsersExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		ctx := context.Background()
+		for _, userID := range testAccPagerDutyUsersIDs(rs) {
+			if _, err := testAccProvider.client.GetUserWithContext(ctx, userID, pagerduty.GetUserOptions{}); err != nil {
+				return fmt.Errorf("User %s not found: %v", userID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccPagerDutyUsersImportStateID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return strings.Join(testAccPagerDutyUsersIDs(rs), ","), nil
+	}
+}
+
+func testAccPagerDutyUsersIDs(rs *terraform.ResourceState) []string {
+	var ids []string
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "users.") && strings.HasSuffix(k, ".id") {
+			ids = append(ids, v)
+		}
+	}
+	return ids
+}
+
+func testAccCheckPagerDutyUsersConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_users" "foo" {
+  users = {
+    "%[1]s-alice@foo.test" = {
+      name = "%[1]s Alice"
+    }
+    "%[1]s-bob@foo.test" = {
+      name      = "%[1]s Bob"
+      job_title = "SRE"
+    }
+  }
+}
+`, prefix)
+}
+
+func testAccCheckPagerDutyUsersConfigJobTitleRemoved(prefix string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_users" "foo" {
+  users = {
+    "%[1]s-alice@foo.test" = {
+      name = "%[1]s Alice"
+    }
+    "%[1]s-bob@foo.test" = {
+      name = "%[1]s Bob"
+    }
+  }
+}
+`, prefix)
+}
+
+func testAccCheckPagerDutyUsersConfigUpdated(prefix string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_users" "foo" {
+  users = {
+    "%[1]s-alice@foo.test" = {
+      name = "%[1]s Alice"
+      role = "observer"
+    }
+    "%[1]s-carol@foo.test" = {
+      name = "%[1]s Carol"
+    }
+  }
+}
+`, prefix)
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+// planGatedFeatureErrSignal is what the heimweh client embeds in its Error()
+// output when the API answers with 402 Payment Required. The PagerDuty
+// go-pagerduty client exposes the status code through pagerduty.APIError
+// instead, so the substring is only needed for the legacy provider.
+const planGatedFeatureErrSignal = "402 payment required"
+
+// PlanGatedFeature names a PagerDuty feature that is only available on some
+// account plans, so a 402 response can be reported in terms of what the
+// operator configured rather than as a bare HTTP failure.
+type PlanGatedFeature struct {
+	Name string
+}
+
+var (
+	FeatureEventOrchestration   = PlanGatedFeature{Name: "Event Orchestration"}
+	FeatureServiceCustomFields  = PlanGatedFeature{Name: "Service Custom Fields"}
+	FeatureIncidentCustomFields = PlanGatedFeature{Name: "Incident Custom Fields"}
+)
+
+// IsPlanGatedFeatureError reports whether err was returned by the API because
+// the account plan does not include the requested feature.
+func IsPlanGatedFeatureError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr pagerduty.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusPaymentRequired
+	}
+
+	return strings.Contains(strings.ToLower(err.Error()), planGatedFeatureErrSignal)
+}
+
+// Error renders the message as a single-string error for SDKv2 resources,
+// preserving the original API error for debuggability.
+func (f PlanGatedFeature) Error(resourceType, id string, cause error) error {
+	summary, detail := f.Diagnostic(resourceType, id, cause)
+	return fmt.Errorf("%s. %s", summary, detail)
+}
+
+// Diagnostic renders the message as a (summary, detail) pair for
+// terraform-plugin-framework resources. id may be empty when the object was
+// never created.
+func (f PlanGatedFeature) Diagnostic(resourceType, id string, cause error) (summary, detail string) {
+	target := resourceType
+	if id != "" {
+		target = fmt.Sprintf("%s %q", resourceType, id)
+	}
+
+	summary = fmt.Sprintf("%s is not included in this PagerDuty account's plan", f.Name)
+	detail = fmt.Sprintf("%s cannot be managed because the PagerDuty API rejected the request with 402 Payment Required. "+
+		"Upgrade the account's plan to one that includes %s or remove this resource from your configuration."+
+		"\n\nOriginal API error: %s", target, f.Name, cause)
+	return summary, detail
+}
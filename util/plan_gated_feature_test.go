@@ -0,0 +1,84 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+func TestIsPlanGatedFeatureError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "unrelated error",
+			err:  errors.New("GET API call to https://api.pagerduty.com/event_orchestrations/PXXXXXX failed 404 Not Found. Code: 0, Errors: [], Message: Not Found"),
+			want: false,
+		},
+		{
+			// Mirrors the heimweh client's Error() output used by the legacy provider.
+			name: "heimweh-style payment required error",
+			err:  errors.New("POST API call to https://api.pagerduty.com/event_orchestrations failed 402 Payment Required. Code: 2012, Errors: [], Message: Account does not have the required ability"),
+			want: true,
+		},
+		{
+			name: "go-pagerduty payment required error",
+			err:  pagerduty.APIError{StatusCode: http.StatusPaymentRequired},
+			want: true,
+		},
+		{
+			name: "go-pagerduty forbidden error",
+			err:  pagerduty.APIError{StatusCode: http.StatusForbidden},
+			want: false,
+		},
+		{
+			name: "wrapped payment required error",
+			err:  fmt.Errorf("Error reading: PXXXXXX: %w", errors.New("failed 402 Payment Required. Errors: []")),
+			want: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := IsPlanGatedFeatureError(c.err); got != c.want {
+				t.Errorf("IsPlanGatedFeatureError(%v) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}
+
+func TestPlanGatedFeatureDiagnostic(t *testing.T) {
+	cause := errors.New("failed 402 Payment Required")
+
+	summary, detail := FeatureEventOrchestration.Diagnostic("pagerduty_event_orchestration", "PXXXXXX", cause)
+	if !strings.Contains(summary, "Event Orchestration") {
+		t.Errorf("expected summary to name the feature, got %q", summary)
+	}
+	if !strings.Contains(detail, `pagerduty_event_orchestration "PXXXXXX"`) {
+		t.Errorf("expected detail to name the resource, got %q", detail)
+	}
+	if !strings.Contains(detail, cause.Error()) {
+		t.Errorf("expected detail to preserve the original API error, got %q", detail)
+	}
+
+	_, detail = FeatureEventOrchestration.Diagnostic("pagerduty_event_orchestration", "", cause)
+	if !strings.HasPrefix(detail, "pagerduty_event_orchestration cannot be managed") {
+		t.Errorf("expected detail without an ID for resources not yet created, got %q", detail)
+	}
+
+	err := FeatureEventOrchestration.Error("pagerduty_event_orchestration", "PXXXXXX", cause)
+	if !strings.HasPrefix(err.Error(), summary+". ") {
+		t.Errorf("expected error to start with the summary, got %q", err.Error())
+	}
+}
@@ -0,0 +1,91 @@
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TokenPermissions describes which resources the API token the provider is
+// configured with can manage, as far as it can be told from the PagerDuty API.
+type TokenPermissions struct {
+	// Role is the base role of the user the token belongs to. It's empty for
+	// account level and OAuth app tokens, which aren't bound to a user role.
+	Role string
+}
+
+// Roles allowed to manage any resource, besides the account management ones.
+var tokenManagerRoles = []string{"owner", "admin", "user"}
+
+// Roles allowed to manage the resources that configure the account itself.
+var tokenAdminRoles = []string{"owner", "admin"}
+
+// Roles only allowed to manage the notification settings of their own user.
+var tokenResponderRoles = []string{"limited_user", "restricted_access"}
+
+// Roles not allowed to manage any resource.
+var tokenReadOnlyRoles = []string{"observer", "read_only_user", "read_only_limited_user"}
+
+var tokenAdminResourceTypes = []string{
+	"pagerduty_addon",
+	"pagerduty_user",
+	"pagerduty_users",
+}
+
+var tokenResponderResourceTypes = []string{
+	"pagerduty_user_contact_method",
+	"pagerduty_user_handoff_notification_rule",
+	"pagerduty_user_notification_rule",
+}
+
+// Check returns an error when the token can't manage resources of the given
+// type. Unknown roles and tokens without a role are not restricted, since
+// the API is the one to tell then.
+func (p TokenPermissions) Check(resourceType string) error {
+	if p.Role == "" || slices.Contains(tokenAdminRoles, p.Role) {
+		return nil
+	}
+
+	var allowed []string
+	switch {
+	case slices.Contains(tokenAdminResourceTypes, resourceType):
+		allowed = tokenAdminRoles
+	case slices.Contains(tokenResponderResourceTypes, resourceType):
+		allowed = append(append([]string{}, tokenManagerRoles...), tokenResponderRoles...)
+	default:
+		allowed = tokenManagerRoles
+	}
+
+	known := slices.Contains(tokenManagerRoles, p.Role) ||
+		slices.Contains(tokenResponderRoles, p.Role) ||
+		slices.Contains(tokenReadOnlyRoles, p.Role)
+	if !known || slices.Contains(allowed, p.Role) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"the API token belongs to a user with the %q role, which %s. Managing %s requires one of the roles: %s",
+		p.Role, p.insufficientPermissions(), resourceType, strings.Join(allowed, ", "),
+	)
+}
+
+// insufficientPermissions describes every resource type the role can't
+// manage, so a single error lists all of them rather than only the one being
+// checked.
+func (p TokenPermissions) insufficientPermissions() string {
+	switch {
+	case slices.Contains(tokenReadOnlyRoles, p.Role):
+		return "can't manage any resource"
+	case slices.Contains(tokenResponderRoles, p.Role):
+		return "can only manage " + joinResourceTypes(tokenResponderResourceTypes)
+	default:
+		return "can't manage " + joinResourceTypes(tokenAdminResourceTypes)
+	}
+}
+
+func joinResourceTypes(types []string) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+	return strings.Join(types[:len(types)-1], ", ") + " and " + types[len(types)-1]
+}
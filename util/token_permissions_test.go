@@ -0,0 +1,74 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenPermissionsCheck(t *testing.T) {
+	cases := []struct {
+		name         string
+		role         string
+		resourceType string
+		wantErr      string
+	}{
+		{
+			name:         "token without role",
+			role:         "",
+			resourceType: "pagerduty_user",
+		},
+		{
+			name:         "admin manages account resources",
+			role:         "admin",
+			resourceType: "pagerduty_users",
+		},
+		{
+			name:         "manager manages services",
+			role:         "user",
+			resourceType: "pagerduty_service",
+		},
+		{
+			name:         "manager can't manage users",
+			role:         "user",
+			resourceType: "pagerduty_user",
+			wantErr:      `"user" role, which can't manage pagerduty_addon, pagerduty_user and pagerduty_users. Managing pagerduty_user requires one of the roles: owner, admin`,
+		},
+		{
+			name:         "responder manages its contact methods",
+			role:         "limited_user",
+			resourceType: "pagerduty_user_contact_method",
+		},
+		{
+			name:         "team restricted user can't manage teams",
+			role:         "restricted_access",
+			resourceType: "pagerduty_team",
+			wantErr:      "which can only manage pagerduty_user_contact_method, pagerduty_user_handoff_notification_rule and pagerduty_user_notification_rule. Managing pagerduty_team requires one of the roles: owner, admin, user",
+		},
+		{
+			name:         "read-only user can't manage anything",
+			role:         "read_only_user",
+			resourceType: "pagerduty_user_notification_rule",
+			wantErr:      "which can't manage any resource. Managing pagerduty_user_notification_rule requires one of the roles: owner, admin, user, limited_user, restricted_access",
+		},
+		{
+			name:         "unknown role isn't restricted",
+			role:         "some_new_role",
+			resourceType: "pagerduty_service",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := TokenPermissions{Role: c.role}.Check(c.resourceType)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+			}
+		})
+	}
+}